@@ -0,0 +1 @@
+ALTER TABLE task ADD COLUMN description TEXT NOT NULL DEFAULT '';
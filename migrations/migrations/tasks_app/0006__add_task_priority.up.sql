@@ -0,0 +1,3 @@
+ALTER TABLE task ADD COLUMN priority SMALLINT NOT NULL DEFAULT 1;
+
+CREATE INDEX idx_task_priority ON task (priority);
@@ -0,0 +1,5 @@
+CREATE INDEX idx_task_search_english ON task USING GIN (to_tsvector('english'::regconfig, name || ' ' || description));
+CREATE INDEX idx_task_search_finnish ON task USING GIN (to_tsvector('finnish'::regconfig, name || ' ' || description));
+
+CREATE INDEX idx_attachment_search_english ON attachment USING GIN (to_tsvector('english'::regconfig, file_name));
+CREATE INDEX idx_attachment_search_finnish ON attachment USING GIN (to_tsvector('finnish'::regconfig, file_name));
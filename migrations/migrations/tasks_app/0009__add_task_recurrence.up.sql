@@ -0,0 +1,4 @@
+ALTER TABLE task ADD COLUMN recurrence VARCHAR(500) NOT NULL DEFAULT '';
+ALTER TABLE task ADD COLUMN recurrence_timezone VARCHAR(100) NOT NULL DEFAULT '';
+ALTER TABLE task ADD COLUMN recurrence_start TIMESTAMPTZ;
+ALTER TABLE task ADD COLUMN recurred_at TIMESTAMPTZ;
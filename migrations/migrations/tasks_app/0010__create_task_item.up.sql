@@ -0,0 +1,13 @@
+CREATE TABLE task_item (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    task_id BIGINT NOT NULL REFERENCES task(id) ON DELETE CASCADE,
+    name VARCHAR(200) NOT NULL,
+    position INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_task_item_task_id ON task_item (task_id, position);
+
+ALTER TABLE task ADD COLUMN auto_complete BOOLEAN NOT NULL DEFAULT FALSE;
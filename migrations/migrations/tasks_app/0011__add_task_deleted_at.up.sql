@@ -0,0 +1,3 @@
+ALTER TABLE task ADD COLUMN deleted_at TIMESTAMPTZ;
+
+CREATE INDEX idx_task_deleted_at ON task (deleted_at) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,11 @@
+CREATE TABLE task_event (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    task_id BIGINT NOT NULL REFERENCES task(id) ON DELETE CASCADE,
+    user_id VARCHAR(200) NOT NULL DEFAULT '',
+    user_name VARCHAR(200) NOT NULL DEFAULT '',
+    type VARCHAR(50) NOT NULL,
+    data JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_task_event_task_id ON task_event (task_id, created_at);
@@ -0,0 +1,13 @@
+CREATE TABLE task_member (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    task_id BIGINT NOT NULL REFERENCES task(id) ON DELETE CASCADE,
+    user_id VARCHAR(200) NOT NULL DEFAULT '',
+    email VARCHAR(200) NOT NULL DEFAULT '',
+    role VARCHAR(50) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (task_id, user_id, email)
+);
+
+CREATE INDEX idx_task_member_user_id ON task_member (user_id) WHERE user_id <> '';
+
+CREATE INDEX idx_task_member_email ON task_member (email) WHERE email <> '';
@@ -0,0 +1,13 @@
+CREATE TABLE project (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id VARCHAR(200) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    expiration_days INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ,
+    UNIQUE (user_id, name)
+);
+
+ALTER TABLE task ADD COLUMN project_id BIGINT REFERENCES project(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_task_project_id ON task (project_id);
@@ -0,0 +1,7 @@
+ALTER TABLE task ADD COLUMN assignee_user_id VARCHAR(200) NOT NULL DEFAULT '';
+
+ALTER TABLE task ADD COLUMN assignee_email VARCHAR(200) NOT NULL DEFAULT '';
+
+CREATE INDEX idx_task_assignee_user_id ON task (assignee_user_id) WHERE assignee_user_id <> '';
+
+CREATE INDEX idx_task_assignee_email ON task (assignee_email) WHERE assignee_email <> '';
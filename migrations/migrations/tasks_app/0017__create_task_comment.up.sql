@@ -0,0 +1,11 @@
+CREATE TABLE task_comment (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    task_id BIGINT NOT NULL REFERENCES task(id) ON DELETE CASCADE,
+    user_id VARCHAR(200) NOT NULL,
+    user_name VARCHAR(200) NOT NULL DEFAULT '',
+    body TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_task_comment_task_id ON task_comment (task_id, created_at);
@@ -0,0 +1,9 @@
+CREATE TABLE task_blocker (
+    task_id BIGINT NOT NULL REFERENCES task(id) ON DELETE CASCADE,
+    blocker_id BIGINT NOT NULL REFERENCES task(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (task_id, blocker_id),
+    CHECK (task_id <> blocker_id)
+);
+
+CREATE INDEX idx_task_blocker_blocker_id ON task_blocker (blocker_id);
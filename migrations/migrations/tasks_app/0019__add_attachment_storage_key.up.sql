@@ -0,0 +1,5 @@
+ALTER TABLE attachment ADD COLUMN storage_key VARCHAR(200);
+
+UPDATE attachment SET storage_key = file_name;
+
+ALTER TABLE attachment ALTER COLUMN storage_key SET NOT NULL;
@@ -0,0 +1,5 @@
+ALTER TABLE attachment ADD COLUMN size BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE attachment ADD COLUMN content_type VARCHAR(200) NOT NULL DEFAULT '';
+
+ALTER TABLE attachment ADD COLUMN sha256 VARCHAR(64) NOT NULL DEFAULT '';
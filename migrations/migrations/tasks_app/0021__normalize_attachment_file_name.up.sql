@@ -0,0 +1,3 @@
+UPDATE attachment
+SET file_name = normalize(btrim(file_name), NFC)
+WHERE file_name <> normalize(btrim(file_name), NFC);
@@ -0,0 +1 @@
+ALTER TABLE api_token ADD COLUMN user_email VARCHAR(200) NOT NULL DEFAULT '';
@@ -20,6 +20,7 @@ type App struct {
 	TxManager                 shared.TxManager
 	TaskAttachmentsRepository shared.TaskAttachmentsRepository
 	MessagingClient           shared.MessagingClient
+	LeaderElector             shared.LeaderElector
 	Modules                   map[string]shared.AppModule
 }
 
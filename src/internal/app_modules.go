@@ -3,6 +3,7 @@ package internal
 import (
 	"log/slog"
 	"tasks-app/internal/modules/emailnotifier"
+	"tasks-app/internal/modules/outboxrelay"
 	"tasks-app/internal/modules/taskchecker"
 	"tasks-app/internal/modules/ui"
 	"tasks-app/internal/shared"
@@ -11,6 +12,7 @@ import (
 const (
 	AppModuleUI                = "ui"
 	AppModuleTaskChecker       = "taskchecker"
+	AppModuleOutboxRelay       = "outboxrelay"
 	AppModuleEmailNotifierNull = "emailnotifier:null"
 	AppModuleEmailNotifierSMTP = "emailnotifier:smtp"
 )
@@ -37,6 +39,18 @@ func (a *App) createModules() error {
 		logger := a.Logger.With(slog.String("module", AppModuleTaskChecker))
 
 		modules[AppModuleTaskChecker] = &taskchecker.Module{
+			Config:                    a.Config,
+			Logger:                    logger,
+			TxManager:                 a.TxManager,
+			TaskAttachmentsRepository: a.TaskAttachmentsRepository,
+			LeaderElector:             a.LeaderElector,
+		}
+	}
+
+	if a.Config.IsModuleEnabled(AppModuleOutboxRelay) {
+		logger := a.Logger.With(slog.String("module", AppModuleOutboxRelay))
+
+		modules[AppModuleOutboxRelay] = &outboxrelay.Module{
 			Config:          a.Config,
 			Logger:          logger,
 			TxManager:       a.TxManager,
@@ -10,7 +10,10 @@ const (
 	AppServiceDBPostgres      = "db:postgres"
 	AppServiceAttachmentsFile = "attachments:file"
 	AppServiceAttachmentsNATS = "attachments:nats"
+	AppServiceAttachmentsS3   = "attachments:s3"
 	AppServiceMessagingNATS   = "messaging:nats"
+	AppServiceLeaderNATS      = "leader:nats"
+	AppServiceLeaderPostgres  = "leader:postgres"
 )
 
 func (a *App) createServices(ctx context.Context) error {
@@ -23,7 +26,7 @@ func (a *App) createServices(ctx context.Context) error {
 		}
 	}
 
-	if a.Config.IsServiceEnabled(AppServiceAttachmentsNATS) || a.Config.IsServiceEnabled(AppServiceMessagingNATS) {
+	if a.Config.IsServiceEnabled(AppServiceAttachmentsNATS) || a.Config.IsServiceEnabled(AppServiceMessagingNATS) || a.Config.IsServiceEnabled(AppServiceLeaderNATS) {
 		a.NATSConn, err = shared.NewNATSConn(a.Config, a.Logger)
 		if err != nil {
 			return fmt.Errorf("create nats connection: %w", err)
@@ -47,6 +50,13 @@ func (a *App) createServices(ctx context.Context) error {
 		}
 	}
 
+	if a.Config.IsServiceEnabled(AppServiceAttachmentsS3) {
+		a.TaskAttachmentsRepository, err = shared.NewS3TaskAttachmentsRepository(ctx, a.Config, a.Logger)
+		if err != nil {
+			return fmt.Errorf("create service %s: %w", AppServiceAttachmentsS3, err)
+		}
+	}
+
 	if a.Config.IsServiceEnabled(AppServiceMessagingNATS) {
 		a.MessagingClient, err = shared.NewNATSMessagingClient(a.NATSConn, a.Logger)
 		if err != nil {
@@ -54,6 +64,18 @@ func (a *App) createServices(ctx context.Context) error {
 		}
 	}
 
+	switch {
+	case a.Config.IsServiceEnabled(AppServiceLeaderNATS):
+		a.LeaderElector, err = shared.NewNATSLeaderElector(a.NATSConn, a.Config, a.Logger)
+		if err != nil {
+			return fmt.Errorf("create service %s: %w", AppServiceLeaderNATS, err)
+		}
+	case a.Config.IsServiceEnabled(AppServiceLeaderPostgres):
+		a.LeaderElector = shared.NewPostgresLeaderElector(a.DB, a.Logger)
+	default:
+		a.LeaderElector = &shared.NullLeaderElector{}
+	}
+
 	return nil
 }
 
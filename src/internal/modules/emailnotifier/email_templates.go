@@ -3,9 +3,24 @@ package emailnotifier
 import (
 	"embed"
 	"html/template"
+	"tasks-app/internal/shared"
 )
 
 //go:embed templates
 var TemplatesFS embed.FS
 
-var Templates = template.Must(template.ParseFS(TemplatesFS, "templates/*.html"))
+var Templates = template.Must(
+	template.New("").
+		Funcs(template.FuncMap{
+			"markdown": Markdown,
+		}).
+		ParseFS(TemplatesFS, "templates/*.html"),
+)
+
+func Markdown(src string) (template.HTML, error) {
+	html, err := shared.RenderMarkdown(src)
+	if err != nil {
+		return "", err
+	}
+	return template.HTML(html), nil
+}
@@ -42,6 +42,10 @@ func (m *Module) handleMessage(ctx context.Context, msg shared.Message) (err err
 		return m.handleTaskExpiringMessage(ctx, msg)
 	} else if strings.HasPrefix(sub, "task.") && strings.HasSuffix(sub, ".expired") {
 		return m.handleTaskExpiredMessage(ctx, msg)
+	} else if strings.HasPrefix(sub, "task.") && strings.HasSuffix(sub, ".commented") {
+		return m.handleTaskCommentedMessage(ctx, msg)
+	} else if strings.HasPrefix(sub, "task.") && strings.HasSuffix(sub, ".unblocked") {
+		return m.handleTaskUnblockedMessage(ctx, msg)
 	} else {
 		return m.handleUnknownMessage(ctx, msg)
 	}
@@ -59,9 +63,8 @@ func (m *Module) handleTaskExpiringMessage(ctx context.Context, msg shared.Messa
 		return err
 	}
 
-	to, err := m.EmailResolver.ResolveEmail(data.Task.UserID)
+	to, err := m.resolveRecipient(msg, data.Email)
 	if err != nil {
-		m.nakMessage(msg)
 		return err
 	}
 
@@ -86,9 +89,8 @@ func (m *Module) handleTaskExpiredMessage(ctx context.Context, msg shared.Messag
 		return err
 	}
 
-	to, err := m.EmailResolver.ResolveEmail(data.Task.UserID)
+	to, err := m.resolveRecipient(msg, data.Email)
 	if err != nil {
-		m.nakMessage(msg)
 		return err
 	}
 
@@ -101,6 +103,85 @@ func (m *Module) handleTaskExpiredMessage(ctx context.Context, msg shared.Messag
 	return nil
 }
 
+func (m *Module) handleTaskUnblockedMessage(ctx context.Context, msg shared.Message) error {
+	if err := m.validator.ValidateBytes("schemas/task.unblocked.json", msg.Data()); err != nil {
+		m.nakMessage(msg)
+		return err
+	}
+
+	var data shared.TaskUnblockedMsg
+	if err := json.Unmarshal(msg.Data(), &data); err != nil {
+		m.nakMessage(msg)
+		return err
+	}
+
+	to, err := m.resolveRecipient(msg, data.Email)
+	if err != nil {
+		return err
+	}
+
+	if err := m.EmailClient.SendEmail(ctx, to, "Task Unblocked", "task_unblocked.html", data.Task); err != nil {
+		m.nakMessage(msg)
+		return err
+	}
+
+	m.ackMessage(msg)
+	return nil
+}
+
+// handleTaskCommentedMessage notifies one recipient of a new comment. The
+// comment is published once per recipient, with the ID of the recipient in
+// the subject: task.{userID}.{taskID}.commented.
+func (m *Module) handleTaskCommentedMessage(ctx context.Context, msg shared.Message) error {
+	if err := m.validator.ValidateBytes("schemas/task.commented.json", msg.Data()); err != nil {
+		m.nakMessage(msg)
+		return err
+	}
+
+	var data shared.TaskCommentedMsg
+	if err := json.Unmarshal(msg.Data(), &data); err != nil {
+		m.nakMessage(msg)
+		return err
+	}
+
+	to, err := m.resolveRecipient(msg, "")
+	if err != nil {
+		return err
+	}
+
+	if err := m.EmailClient.SendEmail(ctx, to, "New Comment", "task_commented.html", data); err != nil {
+		m.nakMessage(msg)
+		return err
+	}
+
+	m.ackMessage(msg)
+	return nil
+}
+
+// resolveRecipient returns the email address of the recipient of a message
+// published once per recipient, task.{userID}.{taskID}.{kind}: the address
+// in the message for an assignee invited by email address, and otherwise the
+// address of the user in the subject. The message is acked or naked on error.
+func (m *Module) resolveRecipient(msg shared.Message, email string) (string, error) {
+	if email != "" {
+		return email, nil
+	}
+
+	tokens := strings.Split(msg.Subject(), ".")
+	if len(tokens) != 4 {
+		m.ackMessage(msg)
+		return "", fmt.Errorf("invalid subject: %s", msg.Subject())
+	}
+
+	to, err := m.EmailResolver.ResolveEmail(tokens[1])
+	if err != nil {
+		m.nakMessage(msg)
+		return "", err
+	}
+
+	return to, nil
+}
+
 func (m *Module) handleUnknownMessage(_ context.Context, msg shared.Message) error {
 	m.ackMessage(msg)
 
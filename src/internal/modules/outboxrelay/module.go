@@ -0,0 +1,91 @@
+package outboxrelay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"tasks-app/internal/shared"
+	"time"
+)
+
+type Module struct {
+	Config          *shared.Config
+	Logger          *slog.Logger
+	TxManager       shared.TxManager
+	MessagingClient shared.MessagingClient
+}
+
+var _ shared.AppModule = (*Module)(nil)
+
+func (m *Module) Run(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(m.Config.OutboxRelay.PollInterval):
+			if err := m.relay(ctx); err != nil {
+				m.Logger.Error("relay outbox", "error", err)
+			}
+		}
+	}
+}
+
+func (m *Module) relay(ctx context.Context) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return errors.Join(
+		m.relayPendingMessages(ctx),
+		m.deleteSentMessages(ctx),
+	)
+}
+
+// relayPendingMessages publishes pending messages and marks them sent in the
+// same transaction. If the commit fails, the messages are published again on
+// the next run and JetStream drops the duplicates based on Nats-Msg-Id.
+func (m *Module) relayPendingMessages(ctx context.Context) error {
+	return m.TxManager.RunInTx(func(txc shared.TxContext) error {
+		msgs, err := txc.OutboxRepository.GetPending(ctx, m.Config.OutboxRelay.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		count := len(msgs)
+		if 0 < count {
+			m.Logger.Info("found pending outbox messages", slog.Int("count", count))
+		}
+
+		for _, msg := range msgs {
+			if err := m.MessagingClient.SendPersistentWithID(ctx, msg.Subject, msg.MsgID(), msg.Data); err != nil {
+				return err
+			}
+
+			msg.SetSent()
+
+			if err := txc.OutboxRepository.Update(ctx, msg); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (m *Module) deleteSentMessages(ctx context.Context) error {
+	return m.TxManager.RunInTx(func(txc shared.TxContext) error {
+		count, err := txc.OutboxRepository.DeleteSent(ctx, m.Config.OutboxRelay.DeleteWindow)
+		if err != nil {
+			return err
+		}
+
+		if 0 < count {
+			m.Logger.Info("deleted sent outbox messages", slog.Int64("count", count))
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,132 @@
+package outboxrelay
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"tasks-app/internal/shared"
+	"testing"
+	"time"
+)
+
+type fakeTxManager struct {
+	outbox *fakeOutboxRepository
+}
+
+func (m *fakeTxManager) RunInTx(fn func(txc shared.TxContext) error) error {
+	return fn(shared.TxContext{OutboxRepository: m.outbox})
+}
+
+type fakeOutboxRepository struct {
+	msgs []*shared.OutboxMessage
+}
+
+func (r *fakeOutboxRepository) Create(_ context.Context, msg *shared.OutboxMessage) error {
+	r.msgs = append(r.msgs, msg)
+	return nil
+}
+
+func (r *fakeOutboxRepository) Update(_ context.Context, _ *shared.OutboxMessage) error {
+	return nil
+}
+
+func (r *fakeOutboxRepository) GetPending(_ context.Context, limit int) ([]*shared.OutboxMessage, error) {
+	var pending []*shared.OutboxMessage
+
+	for _, msg := range r.msgs {
+		if msg.SentAt == nil && len(pending) < limit {
+			pending = append(pending, msg)
+		}
+	}
+
+	return pending, nil
+}
+
+func (r *fakeOutboxRepository) DeleteSent(_ context.Context, _ time.Duration) (int64, error) {
+	return 0, nil
+}
+
+// fakeMessagingClient records the IDs of the published messages and fails
+// the publish number failAt.
+type fakeMessagingClient struct {
+	shared.MessagingClient
+	ids    []string
+	failAt int
+}
+
+func (c *fakeMessagingClient) SendPersistentWithID(_ context.Context, subject string, msgID string, _ any) error {
+	c.ids = append(c.ids, msgID)
+
+	if len(c.ids) == c.failAt {
+		return errors.New("publish failed")
+	}
+
+	return nil
+}
+
+func newTestModule(outbox *fakeOutboxRepository, client *fakeMessagingClient) *Module {
+	config := &shared.Config{}
+	config.OutboxRelay.BatchSize = 10
+
+	return &Module{
+		Config:          config,
+		Logger:          slog.New(slog.NewTextHandler(io.Discard, nil)),
+		TxManager:       &fakeTxManager{outbox},
+		MessagingClient: client,
+	}
+}
+
+func newTestMessages(ids ...int) []*shared.OutboxMessage {
+	msgs := make([]*shared.OutboxMessage, len(ids))
+	for i, id := range ids {
+		msgs[i] = &shared.OutboxMessage{ID: id, Subject: "task.user.1.expired", Data: []byte("{}")}
+	}
+
+	return msgs
+}
+
+func TestRelayPendingMessages(t *testing.T) {
+	outbox := &fakeOutboxRepository{msgs: newTestMessages(1, 2)}
+	client := &fakeMessagingClient{}
+
+	if err := newTestModule(outbox, client).relayPendingMessages(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"outbox.1", "outbox.2"}; !slices.Equal(client.ids, want) {
+		t.Errorf("published IDs = %v, want %v", client.ids, want)
+	}
+
+	for _, msg := range outbox.msgs {
+		if msg.SentAt == nil {
+			t.Errorf("message %d not marked sent", msg.ID)
+		}
+	}
+}
+
+func TestRelayPendingMessagesRepublishesWithSameID(t *testing.T) {
+	outbox := &fakeOutboxRepository{msgs: newTestMessages(1, 2)}
+	client := &fakeMessagingClient{failAt: 2}
+	m := newTestModule(outbox, client)
+
+	if err := m.relayPendingMessages(t.Context()); err == nil {
+		t.Fatal("relayPendingMessages() = nil, want the publish error")
+	}
+
+	// The transaction of the failed run is rolled back, so the message that
+	// was published is pending again.
+	for _, msg := range outbox.msgs {
+		msg.SentAt = nil
+	}
+
+	if err := m.relayPendingMessages(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+
+	// JetStream drops the second outbox.1 as a duplicate.
+	if want := []string{"outbox.1", "outbox.2", "outbox.1", "outbox.2"}; !slices.Equal(client.ids, want) {
+		t.Errorf("published IDs = %v, want %v", client.ids, want)
+	}
+}
@@ -10,20 +10,36 @@ import (
 )
 
 type Module struct {
-	Config          *shared.Config
-	Logger          *slog.Logger
-	TxManager       shared.TxManager
-	MessagingClient shared.MessagingClient
+	Config                    *shared.Config
+	Logger                    *slog.Logger
+	TxManager                 shared.TxManager
+	TaskAttachmentsRepository shared.TaskAttachmentsRepository
+	LeaderElector             shared.LeaderElector
 }
 
 var _ shared.AppModule = (*Module)(nil)
 
+const leaderName = "taskchecker"
+
 func (m *Module) Run(ctx context.Context) error {
+	defer m.resign()
+
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
 		case <-time.After(m.Config.TaskChecker.CheckInterval):
+			isLeader, err := m.LeaderElector.IsLeader(ctx, leaderName)
+			if err != nil {
+				m.Logger.Error("elect leader", "error", err)
+				continue
+			}
+
+			if !isLeader {
+				m.Logger.Info("skip checks, not the leader")
+				continue
+			}
+
 			if err := m.checkTasks(ctx); err != nil {
 				m.Logger.Error("run checks", "error", err)
 			}
@@ -31,6 +47,15 @@ func (m *Module) Run(ctx context.Context) error {
 	}
 }
 
+func (m *Module) resign() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := m.LeaderElector.Resign(ctx, leaderName); err != nil {
+		m.Logger.Error("resign leader", "error", err)
+	}
+}
+
 func (m *Module) checkTasks(ctx context.Context) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -40,6 +65,7 @@ func (m *Module) checkTasks(ctx context.Context) (err error) {
 
 	return errors.Join(
 		m.checkCompletedTasks(ctx),
+		m.checkDeletedTasks(ctx),
 		m.checkExpiringTasks(ctx),
 		m.checkExpiredTasks(ctx),
 	)
@@ -48,18 +74,62 @@ func (m *Module) checkTasks(ctx context.Context) (err error) {
 func (m *Module) checkCompletedTasks(ctx context.Context) error {
 	m.Logger.Info("check completed tasks")
 
-	return m.TxManager.RunInTx(func(txc shared.TxContext) error {
-		count, err := txc.TaskRepository.DeleteCompleted(ctx, m.Config.TaskChecker.DeleteWindow)
-		if err != nil {
-			return err
-		}
+	var ids []int
+	var err error
 
-		if 0 < count {
-			m.Logger.Info("found completed tasks", slog.Int64("count", count))
-		}
+	err = m.TxManager.RunInTx(func(txc shared.TxContext) error {
+		ids, err = txc.TaskRepository.DeleteCompleted(ctx, m.Config.TaskChecker.DeleteWindow)
+		return err
+	})
+
+	if err != nil {
+		return err
+	}
 
-		return nil
+	count := len(ids)
+	if 0 < count {
+		m.Logger.Info("found completed tasks", slog.Int("count", count))
+	}
+
+	return m.deleteAttachments(ctx, ids)
+}
+
+func (m *Module) checkDeletedTasks(ctx context.Context) error {
+	m.Logger.Info("check deleted tasks")
+
+	var ids []int
+	var err error
+
+	err = m.TxManager.RunInTx(func(txc shared.TxContext) error {
+		ids, err = txc.TaskRepository.PurgeDeleted(ctx, m.Config.TaskChecker.TrashWindow)
+		return err
 	})
+
+	if err != nil {
+		return err
+	}
+
+	count := len(ids)
+	if 0 < count {
+		m.Logger.Info("found deleted tasks", slog.Int("count", count))
+	}
+
+	return m.deleteAttachments(ctx, ids)
+}
+
+// deleteAttachments deletes the attachments of the deleted tasks. It runs
+// after the tasks have been deleted, since an orphaned file is harmless but an
+// attachment without its file is not.
+func (m *Module) deleteAttachments(ctx context.Context, ids []int) error {
+	var errs []error
+
+	for _, id := range ids {
+		if err := m.TaskAttachmentsRepository.DeleteTask(ctx, id); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
 }
 
 func (m *Module) checkExpiringTasks(ctx context.Context) error {
@@ -92,10 +162,23 @@ func (m *Module) checkExpiringTasks(ctx context.Context) error {
 				return err
 			}
 
-			if err := m.MessagingClient.SendPersistent(ctx, fmt.Sprintf("task.%s.%d.expiring", task.UserID, task.ID), shared.TaskExpiringMsg{Task: task}); err != nil {
+			event := shared.NewTaskEvent(task.ID, shared.TaskEventNotified, shared.TaskEventData{Notification: shared.TaskNotificationExpiring})
+
+			if err := txc.TaskEventRepository.Create(ctx, event); err != nil {
 				return err
 			}
 
+			for _, to := range task.NotificationRecipients() {
+				msg, err := shared.NewOutboxMessage(fmt.Sprintf("task.%s.%d.expiring", to.UserID, task.ID), shared.TaskExpiringMsg{Task: task, Email: to.Email})
+				if err != nil {
+					return err
+				}
+
+				if err := txc.OutboxRepository.Create(ctx, msg); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		})
 
@@ -129,14 +212,31 @@ func (m *Module) checkExpiredTasks(ctx context.Context) error {
 		task.SetExpiredInfoAt()
 
 		err = m.TxManager.RunInTx(func(txc shared.TxContext) error {
+			if _, err := shared.CreateNextOccurrence(ctx, txc, task); err != nil {
+				return err
+			}
+
 			if err := txc.TaskRepository.Update(ctx, task); err != nil {
 				return err
 			}
 
-			if err := m.MessagingClient.SendPersistent(ctx, fmt.Sprintf("task.%s.%d.expired", task.UserID, task.ID), shared.TaskExpiredMsg{Task: task}); err != nil {
+			event := shared.NewTaskEvent(task.ID, shared.TaskEventNotified, shared.TaskEventData{Notification: shared.TaskNotificationExpired})
+
+			if err := txc.TaskEventRepository.Create(ctx, event); err != nil {
 				return err
 			}
 
+			for _, to := range task.NotificationRecipients() {
+				msg, err := shared.NewOutboxMessage(fmt.Sprintf("task.%s.%d.expired", to.UserID, task.ID), shared.TaskExpiredMsg{Task: task, Email: to.Email})
+				if err != nil {
+					return err
+				}
+
+				if err := txc.OutboxRepository.Create(ctx, msg); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		})
 
@@ -0,0 +1,75 @@
+package ui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"tasks-app/internal/shared"
+)
+
+const (
+	ContentTypeJSON        = "application/json"
+	ContentTypeProblemJSON = "application/problem+json"
+)
+
+var ErrPreconditionFailed = errors.New("precondition failed")
+
+// Problem is an RFC 9457 problem details object.
+type Problem struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Detail string `json:"detail,omitempty"`
+}
+
+func WriteJSON(w http.ResponseWriter, status int, data any) {
+	w.Header().Set("Content-Type", ContentTypeJSON)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(data)
+}
+
+func WriteProblem(w http.ResponseWriter, status int, detail string) {
+	w.Header().Set("Content-Type", ContentTypeProblemJSON)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(&Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+	})
+}
+
+// WriteTaskJSON writes the task with its version as the entity tag.
+func WriteTaskJSON(w http.ResponseWriter, status int, task *shared.Task) {
+	w.Header().Set("ETag", TaskETag(task))
+	WriteJSON(w, status, NewAPITaskResponse(task))
+}
+
+func TaskETag(task *shared.Task) string {
+	return fmt.Sprintf(`"%d"`, task.Version)
+}
+
+// IfMatch reports whether the If-Match header of the request matches the
+// entity tag. A request without the header matches any entity tag. Weak
+// entity tags never match.
+func IfMatch(r *http.Request, etag string) bool {
+	header := r.Header.Get("If-Match")
+	if header == "" {
+		return true
+	}
+
+	for v := range strings.SplitSeq(header, ",") {
+		v = strings.TrimSpace(v)
+		if v == "*" || v == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TaskAPIURL(id int) string {
+	return fmt.Sprintf("/api/v1/tasks/%d", id)
+}
@@ -0,0 +1,572 @@
+package ui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"tasks-app/internal/shared"
+	"time"
+)
+
+const (
+	TaskFilterActive    = "active"
+	TaskFilterCompleted = "completed"
+)
+
+var SupportedTaskFilters = []string{
+	TaskFilterActive,
+	TaskFilterCompleted,
+}
+
+const (
+	APILimitDefault = 50
+	APILimitMax     = 1000
+)
+
+type APITasksRequest struct {
+	Filter   string
+	Sort     shared.TaskSort
+	Tag      string
+	Project  int
+	Assigned bool
+	Cursor   *shared.TaskCursor
+	Limit    int
+}
+
+type APINewTaskRequest struct {
+	Name         string
+	Description  string
+	Priority     shared.Priority
+	Tags         []string
+	ExpiresAt    *time.Time
+	Recurrence   string
+	Timezone     string
+	AutoComplete bool
+	ProjectID    *int
+	Assignee     *AssigneeRequest
+}
+
+type APIUpdateTaskRequest struct {
+	ID           int
+	Name         string
+	Description  string
+	Priority     shared.Priority
+	Tags         []string
+	ExpiresAt    *time.Time
+	Recurrence   string
+	Timezone     string
+	AutoComplete bool
+	ProjectID    *int
+	Assignee     *AssigneeRequest
+}
+
+type APITasksSearchRequest struct {
+	Query    string
+	Language string
+	Offset   int
+	Limit    int
+}
+
+type APITaskAttachmentsRequest struct {
+	ID    int
+	Files []*multipart.FileHeader
+}
+
+type APITasksResponse struct {
+	Tasks      []*APITask `json:"tasks"`
+	NextCursor string     `json:"next_cursor,omitempty"`
+}
+
+type APIProjectsResponse struct {
+	Projects []*shared.Project `json:"projects"`
+}
+
+type APITasksSearchResponse struct {
+	Results []*APITaskSearchResult `json:"results"`
+}
+
+type APITaskSearchResult struct {
+	Task                 *APITask `json:"task"`
+	Rank                 float64  `json:"rank"`
+	NameHighlight        string   `json:"name_highlight"`
+	DescriptionHighlight string   `json:"description_highlight"`
+}
+
+type APITaskResponse struct {
+	Task *APITask `json:"task"`
+}
+
+// APITask is a task as returned by the API. It leaves out the bookkeeping of
+// the notifications and of the recurrence, and the storage keys of the
+// attachments.
+type APITask struct {
+	ID                 int                 `json:"id"`
+	UserID             string              `json:"user_id"`
+	ProjectID          *int                `json:"project_id"`
+	AssigneeUserID     string              `json:"assignee_user_id"`
+	AssigneeEmail      string              `json:"assignee_email"`
+	Name               string              `json:"name"`
+	Description        string              `json:"description"`
+	Priority           shared.Priority     `json:"priority"`
+	ExpiresAt          *time.Time          `json:"expires_at"`
+	Recurrence         string              `json:"recurrence"`
+	RecurrenceTimezone string              `json:"recurrence_timezone"`
+	AutoComplete       bool                `json:"auto_complete"`
+	CreatedAt          time.Time           `json:"created_at"`
+	UpdatedAt          *time.Time          `json:"updated_at"`
+	CompletedAt        *time.Time          `json:"completed_at"`
+	DeletedAt          *time.Time          `json:"deleted_at"`
+	Version            int                 `json:"version"`
+	Attachments        []*APIAttachment    `json:"attachments"`
+	Tags               shared.Tags         `json:"tags"`
+	Items              shared.TaskItems    `json:"items"`
+	Members            shared.TaskMembers  `json:"members"`
+	Blockers           shared.TaskBlockers `json:"blockers"`
+}
+
+type APIAttachment struct {
+	ID          int        `json:"id"`
+	TaskID      int        `json:"task_id"`
+	FileName    string     `json:"file_name"`
+	Size        int64      `json:"size"`
+	ContentType string     `json:"content_type"`
+	SHA256      string     `json:"sha256"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at"`
+}
+
+type apiTaskBody struct {
+	Name         string     `json:"name"`
+	Description  string     `json:"description"`
+	Priority     string     `json:"priority"`
+	Tags         []string   `json:"tags"`
+	ExpiresAt    *time.Time `json:"expires_at"`
+	Recurrence   string     `json:"recurrence"`
+	Timezone     string     `json:"recurrence_timezone"`
+	AutoComplete bool       `json:"auto_complete"`
+	ProjectID    int        `json:"project_id"`
+	Assignee     string     `json:"assignee"`
+}
+
+func NewAPITasksResponse(page *shared.TaskPage) *APITasksResponse {
+	res := &APITasksResponse{Tasks: make([]*APITask, len(page.Tasks))}
+
+	for i, task := range page.Tasks {
+		res.Tasks[i] = NewAPITask(task)
+	}
+
+	if page.Next != nil {
+		res.NextCursor = page.Next.String()
+	}
+
+	return res
+}
+
+func NewAPIProjectsResponse(projects []*shared.Project) *APIProjectsResponse {
+	if projects == nil {
+		projects = []*shared.Project{}
+	}
+
+	return &APIProjectsResponse{projects}
+}
+
+func NewAPITasksSearchResponse(results []*shared.TaskSearchResult) *APITasksSearchResponse {
+	res := make([]*APITaskSearchResult, len(results))
+	for i, r := range results {
+		res[i] = &APITaskSearchResult{
+			Task:                 NewAPITask(r.Task),
+			Rank:                 r.Rank,
+			NameHighlight:        HighlightHTML(r.NameHighlight),
+			DescriptionHighlight: HighlightHTML(r.DescriptionHighlight),
+		}
+	}
+
+	return &APITasksSearchResponse{res}
+}
+
+func NewAPITaskResponse(task *shared.Task) *APITaskResponse {
+	return &APITaskResponse{NewAPITask(task)}
+}
+
+func NewAPITask(task *shared.Task) *APITask {
+	attachments := make([]*APIAttachment, len(task.Attachments))
+	for i, a := range task.Attachments {
+		attachments[i] = &APIAttachment{
+			ID:          a.ID,
+			TaskID:      a.TaskID,
+			FileName:    a.FileName,
+			Size:        a.Size,
+			ContentType: a.ContentType,
+			SHA256:      a.SHA256,
+			CreatedAt:   a.CreatedAt,
+			UpdatedAt:   a.UpdatedAt,
+		}
+	}
+
+	return &APITask{
+		ID:                 task.ID,
+		UserID:             task.UserID,
+		ProjectID:          task.ProjectID,
+		AssigneeUserID:     task.AssigneeUserID,
+		AssigneeEmail:      task.AssigneeEmail,
+		Name:               task.Name,
+		Description:        task.Description,
+		Priority:           task.Priority,
+		ExpiresAt:          task.ExpiresAt,
+		Recurrence:         task.Recurrence,
+		RecurrenceTimezone: task.RecurrenceTimezone,
+		AutoComplete:       task.AutoComplete,
+		CreatedAt:          task.CreatedAt,
+		UpdatedAt:          task.UpdatedAt,
+		CompletedAt:        task.CompletedAt,
+		DeletedAt:          task.DeletedAt,
+		Version:            task.Version,
+		Attachments:        attachments,
+		Tags:               task.Tags,
+		Items:              task.Items,
+		Members:            task.Members,
+		Blockers:           task.Blockers,
+	}
+}
+
+func ParseAPITasksRequest(r *http.Request) (*APITasksRequest, error) {
+	var errs []error
+
+	filter, err := ParseTaskFilter(r.FormValue("filter"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	supportedSorts := shared.SupportedActiveTaskSorts
+	if filter == TaskFilterCompleted {
+		supportedSorts = shared.SupportedCompletedTaskSorts
+	}
+
+	sort, err := ParseSort(r.FormValue("sort"), supportedSorts)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	tag, err := ParseTaskTag(r.FormValue("tag"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	project, err := ParseTaskProjectFilter(r.FormValue("project"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	assigned, err := ParseTaskAssignedFilter(r.FormValue("assigned"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	cursor, err := ParseTaskCursor(r.FormValue("cursor"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	limit, err := ParseLimit(r.FormValue("limit"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &APITasksRequest{filter, sort, tag, project, assigned, cursor, limit}, nil
+}
+
+func ParseAPITasksSearchRequest(r *http.Request) (*APITasksSearchRequest, error) {
+	var errs []error
+
+	query, err := ParseSearchQuery(r.FormValue("q"))
+	if err != nil {
+		errs = append(errs, err)
+	} else if query == "" {
+		errs = append(errs, errors.New("q: required"))
+	}
+
+	language := r.FormValue("language")
+	if language == "" {
+		language = SupportedLanguages[0]
+	} else if language, err = ParseLanguage(language); err != nil {
+		errs = append(errs, err)
+	}
+
+	offset, err := ParseOffset(r.FormValue("offset"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	limit, err := ParseLimit(r.FormValue("limit"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &APITasksSearchRequest{query, language, offset, limit}, nil
+}
+
+func ParseAPINewTaskRequest(r *http.Request) (*APINewTaskRequest, error) {
+	body, err := parseAPITaskBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+
+	name, err := ParseTaskName(body.Name)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	description, err := ParseTaskDescription(body.Description)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	priority, err := ParseTaskPriority(body.Priority)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	tags, err := ParseTaskTags(body.Tags)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	recurrence, err := ParseTaskRecurrence(body.Recurrence)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	timezone, err := ParseAPITaskTimezone(body.Timezone)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	projectID, err := ParseAPITaskProject(body.ProjectID)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	assignee, err := ParseTaskAssignee(body.Assignee)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &APINewTaskRequest{name, description, priority, tags, toUTC(body.ExpiresAt), recurrence, timezone, body.AutoComplete, projectID, assignee}, nil
+}
+
+func ParseAPIUpdateTaskRequest(r *http.Request) (*APIUpdateTaskRequest, error) {
+	var errs []error
+
+	id, err := ParseTaskID(r.PathValue("id"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	body, err := parseAPITaskBody(r)
+	if err != nil {
+		return nil, errors.Join(append(errs, err)...)
+	}
+
+	name, err := ParseTaskName(body.Name)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	description, err := ParseTaskDescription(body.Description)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	priority, err := ParseTaskPriority(body.Priority)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	tags, err := ParseTaskTags(body.Tags)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	recurrence, err := ParseTaskRecurrence(body.Recurrence)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	timezone, err := ParseAPITaskTimezone(body.Timezone)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	projectID, err := ParseAPITaskProject(body.ProjectID)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	assignee, err := ParseTaskAssignee(body.Assignee)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &APIUpdateTaskRequest{id, name, description, priority, tags, toUTC(body.ExpiresAt), recurrence, timezone, body.AutoComplete, projectID, assignee}, nil
+}
+
+func ParseAPITaskAttachmentsRequest(r *http.Request) (*APITaskAttachmentsRequest, error) {
+	if err := parseMultipartForm(r); err != nil {
+		return nil, err
+	}
+
+	var errs []error
+
+	id, err := ParseTaskID(r.PathValue("id"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	files := r.MultipartForm.File["attachments"]
+	if len(files) == 0 {
+		errs = append(errs, errors.New("attachments: required"))
+	}
+
+	if err := ParseTaskAttachmentFiles(files); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &APITaskAttachmentsRequest{id, files}, nil
+}
+
+func ParseTaskFilter(value string) (string, error) {
+	if value == "" {
+		return TaskFilterActive, nil
+	}
+
+	if !slices.Contains(SupportedTaskFilters, value) {
+		return "", fmt.Errorf("filter: supported values: %s", strings.Join(SupportedTaskFilters, ", "))
+	}
+
+	return value, nil
+}
+
+// ParseAPITaskProject parses the project of a task body. A missing project or
+// 0 leaves the task without a project.
+func ParseAPITaskProject(value int) (*int, error) {
+	if value < 0 {
+		return nil, errors.New("project_id: must be an integer greater than or equal to 0")
+	}
+
+	if value == 0 {
+		return nil, nil
+	}
+
+	return &value, nil
+}
+
+// ParseTaskAssignedFilter parses the assignee filter of the task lists. The
+// only supported value is "me", which lists the tasks assigned to the caller.
+func ParseTaskAssignedFilter(value string) (bool, error) {
+	switch value {
+	case "":
+		return false, nil
+	case "me":
+		return true, nil
+	default:
+		return false, errors.New("assigned: supported values: me")
+	}
+}
+
+func ParseOffset(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	v, err := strconv.Atoi(value)
+	if err != nil || v < 0 {
+		return 0, errors.New("offset: must be an integer greater than or equal to 0")
+	}
+
+	return v, nil
+}
+
+func ParseTaskCursor(value string) (*shared.TaskCursor, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	cursor, err := shared.ParseTaskCursor(value)
+	if err != nil {
+		return nil, errors.New("cursor: invalid")
+	}
+
+	return cursor, nil
+}
+
+func ParseLimit(value string) (int, error) {
+	if value == "" {
+		return APILimitDefault, nil
+	}
+
+	v, err := strconv.Atoi(value)
+	if err != nil || v < 1 || APILimitMax < v {
+		return 0, fmt.Errorf("limit: must be an integer between 1 and %d", APILimitMax)
+	}
+
+	return v, nil
+}
+
+func ParseAPITaskTimezone(value string) (string, error) {
+	if value == "" {
+		return TimezoneDefault, nil
+	}
+
+	if !IsValidTimezone(value) {
+		return "", fmt.Errorf("recurrence_timezone: supported values: %s", strings.Join(SupportedTimezones, ", "))
+	}
+
+	return value, nil
+}
+
+func parseAPITaskBody(r *http.Request) (*apiTaskBody, error) {
+	body := &apiTaskBody{}
+
+	if err := json.NewDecoder(http.MaxBytesReader(nil, r.Body, 1<<20)).Decode(body); err != nil {
+		return nil, errors.New("body: must be a valid JSON object")
+	}
+
+	return body, nil
+}
+
+func toUTC(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	v := t.UTC()
+
+	return &v
+}
@@ -0,0 +1,37 @@
+package ui
+
+import (
+	"encoding/json"
+	"strings"
+	"tasks-app/internal/shared"
+	"testing"
+)
+
+func TestNewAPITaskLeavesOutInternalFields(t *testing.T) {
+	now := shared.UTCNow()
+
+	task := &shared.Task{
+		ID:              1,
+		Name:            "task",
+		RecurrenceStart: &now,
+		RecurredAt:      &now,
+		ExpiringInfoAt:  &now,
+		ExpiredInfoAt:   &now,
+		Attachments:     shared.Attachments{{ID: 2, FileName: "file.txt", StorageKey: "KEY"}},
+	}
+
+	b, err := json.Marshal(NewAPITask(task))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, field := range []string{"recurrence_start", "recurred_at", "expiring_info_at", "expired_info_at", "storage_key", "KEY"} {
+		if strings.Contains(string(b), field) {
+			t.Errorf("NewAPITask() = %s, contains %q", b, field)
+		}
+	}
+
+	if !strings.Contains(string(b), `"file_name":"file.txt"`) {
+		t.Errorf("NewAPITask() = %s, want the attachment", b)
+	}
+}
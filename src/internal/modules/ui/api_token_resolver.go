@@ -0,0 +1,57 @@
+package ui
+
+import (
+	"context"
+	"tasks-app/internal/shared"
+)
+
+// APITokenResolver resolves personal access tokens. Other bearer tokens are
+// passed on to the next resolver.
+type APITokenResolver struct {
+	TxManager shared.TxManager
+	Next      TokenResolver
+}
+
+var _ TokenResolver = (*APITokenResolver)(nil)
+
+func (r *APITokenResolver) ResolveToken(ctx context.Context, value string) (*shared.UserContext, error) {
+	if !shared.IsAPIToken(value) {
+		if r.Next == nil {
+			return nil, ErrInvalidToken
+		}
+		return r.Next.ResolveToken(ctx, value)
+	}
+
+	var token *shared.APIToken
+	var err error
+
+	err = r.TxManager.RunInTx(func(txc shared.TxContext) error {
+		if token, err = txc.APITokenRepository.GetByHash(ctx, shared.HashAPIToken(value)); err != nil {
+			return err
+		}
+
+		if !token.IsActive() {
+			return ErrInvalidToken
+		}
+
+		if !token.SetLastUsed() {
+			return nil
+		}
+
+		return txc.APITokenRepository.Update(ctx, token)
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			return nil, ErrInvalidToken
+		}
+		return nil, err
+	}
+
+	return &shared.UserContext{
+		ID:            token.UserID,
+		Email:         token.UserEmail,
+		EmailVerified: token.UserEmail != "",
+		Scopes:        token.Scopes,
+	}, nil
+}
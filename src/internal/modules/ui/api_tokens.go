@@ -0,0 +1,5 @@
+package ui
+
+// SupportedAPITokenExpiresIn lists the token lifetimes, in days, offered in
+// the UI. Zero means the token never expires.
+var SupportedAPITokenExpiresIn = []int{30, 90, 365, 0}
@@ -0,0 +1,27 @@
+package ui
+
+import (
+	"context"
+	"tasks-app/internal/shared"
+)
+
+// AssignTask delegates the task to the assignee of the request. A nil request
+// or an unchanged assignee leaves the task as is. Only the owner assigns a
+// task.
+func AssignTask(ctx context.Context, task *shared.Task, assignee *AssigneeRequest) error {
+	if assignee == nil {
+		return nil
+	}
+
+	if task.AssigneeUserID == assignee.UserID && task.AssigneeEmail == assignee.Email {
+		return nil
+	}
+
+	if err := shared.AuthorizeTask(ctx, task, shared.TaskRoleOwner); err != nil {
+		return err
+	}
+
+	task.Assign(assignee.UserID, assignee.Email)
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+	"tasks-app/internal/shared"
+)
+
+// attachmentFormOverhead is the allowance for the form fields and the
+// multipart framing of an upload request.
+const attachmentFormOverhead = 1 << 20
+
+// AttachmentLimits are the limits for uploaded attachments. A zero limit and
+// an empty list of allowed types disable the check.
+type AttachmentLimits struct {
+	MaxFileSize  int64
+	AllowedTypes []string
+	MaxTaskFiles int
+	UserQuota    int64
+}
+
+func NewAttachmentLimits(config *shared.UIConfig) *AttachmentLimits {
+	return &AttachmentLimits{
+		MaxFileSize:  config.AttachmentMaxFileSize,
+		AllowedTypes: config.AttachmentAllowedTypes,
+		MaxTaskFiles: config.AttachmentMaxTaskFiles,
+		UserQuota:    config.AttachmentUserQuota,
+	}
+}
+
+// AttachmentLimitError is a violated attachment limit. The message is looked
+// up from the translations, so that it can be shown in the language of the
+// user.
+type AttachmentLimitError struct {
+	Key  string
+	Args []any
+}
+
+func (e *AttachmentLimitError) Error() string {
+	return e.Translate(Translations[LanguageDefault])
+}
+
+func (e *AttachmentLimitError) Translate(t map[string]string) string {
+	return fmt.Sprintf(t[e.Key], e.Args...)
+}
+
+// MaxRequestSize returns the largest upload request that can be within the
+// limits, or zero if the size of requests is not limited.
+func (l *AttachmentLimits) MaxRequestSize() int64 {
+	if l.MaxFileSize <= 0 || l.MaxTaskFiles <= 0 {
+		return 0
+	}
+
+	return l.MaxFileSize*int64(l.MaxTaskFiles) + attachmentFormOverhead
+}
+
+// LimitRequest rejects upload requests that are larger than any request
+// within the limits, before the files are received.
+func (l *AttachmentLimits) LimitRequest(w http.ResponseWriter, r *http.Request) *AttachmentLimitError {
+	size := l.MaxRequestSize()
+	if size == 0 {
+		return nil
+	}
+
+	if r.ContentLength > size {
+		return l.requestTooLargeError()
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, size)
+
+	return nil
+}
+
+// RequestTooLarge returns the limit error for a request body that LimitRequest
+// cut off, which happens when the length of the request is not known in
+// advance, and nil for other errors.
+func (l *AttachmentLimits) RequestTooLarge(err error) *AttachmentLimitError {
+	var maxErr *http.MaxBytesError
+	if !errors.As(err, &maxErr) {
+		return nil
+	}
+
+	return l.requestTooLargeError()
+}
+
+func (l *AttachmentLimits) requestTooLargeError() *AttachmentLimitError {
+	return &AttachmentLimitError{"attachment_request_too_large", []any{FormatSize(l.MaxRequestSize() - attachmentFormOverhead)}}
+}
+
+// Check checks the files and the number of attachments of a task after the
+// update, and the total size of the attachments of the owner of the task.
+// Check must run in the transaction that saves the update: the usage of the
+// owner stays locked until the transaction ends.
+func (l *AttachmentLimits) Check(ctx context.Context, txc shared.TxContext, task *shared.Task, update *AttachmentsUpdate) error {
+	for _, upload := range update.Uploads {
+		a := upload.Attachment
+
+		if l.MaxFileSize > 0 && a.Size > l.MaxFileSize {
+			return &AttachmentLimitError{"attachment_file_too_large", []any{a.FileName, FormatSize(l.MaxFileSize)}}
+		}
+
+		if !l.IsAllowedType(a) {
+			return &AttachmentLimitError{"attachment_type_not_allowed", []any{a.FileName}}
+		}
+	}
+
+	count := len(task.Attachments) + len(update.Inserted) - len(update.Deleted)
+	if l.MaxTaskFiles > 0 && len(update.Inserted) > 0 && count > l.MaxTaskFiles {
+		return &AttachmentLimitError{"attachment_too_many_files", []any{l.MaxTaskFiles}}
+	}
+
+	if l.UserQuota <= 0 {
+		return nil
+	}
+
+	growth := attachmentsSize(update.Inserted) + attachmentsSize(update.Updated) - attachmentsSize(update.Deleted)
+	for _, a := range update.Updated {
+		if c := findAttachmentByID(task, a.ID); c != nil {
+			growth -= c.Size
+		}
+	}
+
+	if growth <= 0 {
+		return nil
+	}
+
+	if err := txc.TaskRepository.LockAttachmentUsage(ctx, task.UserID); err != nil {
+		return err
+	}
+
+	usage, err := txc.TaskRepository.GetAttachmentUsage(ctx, task.UserID)
+	if err != nil {
+		return err
+	}
+
+	if usage+growth > l.UserQuota {
+		return &AttachmentLimitError{"attachment_quota_exceeded", []any{FormatSize(l.UserQuota)}}
+	}
+
+	return nil
+}
+
+// IsAllowedType tells whether the detected MIME type or the file name
+// extension of the attachment is allowed. Allowed types are MIME types, MIME
+// type wildcards such as image/* or file name extensions such as .pdf.
+func (l *AttachmentLimits) IsAllowedType(a *shared.Attachment) bool {
+	if len(l.AllowedTypes) == 0 {
+		return true
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(a.ContentType)
+	ext := strings.ToLower(filepath.Ext(a.FileName))
+
+	return slices.ContainsFunc(l.AllowedTypes, func(allowed string) bool {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+
+		switch {
+		case strings.HasPrefix(allowed, "."):
+			return allowed == ext
+		case strings.HasSuffix(allowed, "/*"):
+			return strings.HasPrefix(mediaType, strings.TrimSuffix(allowed, "*"))
+		default:
+			return allowed == mediaType
+		}
+	})
+}
+
+func attachmentsSize(attachments []*shared.Attachment) int64 {
+	var size int64
+	for _, a := range attachments {
+		size += a.Size
+	}
+
+	return size
+}
+
+func findAttachmentByID(task *shared.Task, id int) *shared.Attachment {
+	i := slices.IndexFunc(task.Attachments, func(a *shared.Attachment) bool {
+		return a.ID == id
+	})
+	if i < 0 {
+		return nil
+	}
+
+	return task.Attachments[i]
+}
@@ -0,0 +1,171 @@
+package ui
+
+import (
+	"context"
+	"tasks-app/internal/shared"
+	"testing"
+)
+
+func TestAttachmentLimitsIsAllowedType(t *testing.T) {
+	limits := &AttachmentLimits{AllowedTypes: []string{"application/pdf", " Image/* ", ".DOCX"}}
+
+	tests := []struct {
+		name        string
+		fileName    string
+		contentType string
+		want        bool
+	}{
+		{"MIME type", "report.bin", "application/pdf", true},
+		{"MIME type with parameters", "report.bin", "application/pdf; charset=binary", true},
+		{"MIME type wildcard", "photo.bin", "image/png", true},
+		{"file name extension", "letter.docx", "application/zip", true},
+		{"file name extension in upper case", "LETTER.DOCX", "application/zip", true},
+		{"other type", "notes.txt", "text/plain; charset=utf-8", false},
+		{"wildcard does not match a prefix of the type", "x.bin", "imagex/png", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &shared.Attachment{FileName: tt.fileName, ContentType: tt.contentType}
+
+			if got := limits.IsAllowedType(a); got != tt.want {
+				t.Errorf("IsAllowedType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if !(&AttachmentLimits{}).IsAllowedType(&shared.Attachment{FileName: "notes.txt", ContentType: "text/plain"}) {
+		t.Error("IsAllowedType() = false without allowed types")
+	}
+}
+
+func TestAttachmentLimitsMaxRequestSize(t *testing.T) {
+	tests := []struct {
+		name   string
+		limits AttachmentLimits
+		want   int64
+	}{
+		{"limited", AttachmentLimits{MaxFileSize: 100, MaxTaskFiles: 3}, 300 + attachmentFormOverhead},
+		{"no file size limit", AttachmentLimits{MaxTaskFiles: 3}, 0},
+		{"no file count limit", AttachmentLimits{MaxFileSize: 100}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.limits.MaxRequestSize(); got != tt.want {
+				t.Errorf("MaxRequestSize() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+type fakeAttachmentUsageRepository struct {
+	shared.TaskRepository
+	usage  int64
+	locked bool
+}
+
+func (r *fakeAttachmentUsageRepository) LockAttachmentUsage(_ context.Context, _ string) error {
+	r.locked = true
+	return nil
+}
+
+func (r *fakeAttachmentUsageRepository) GetAttachmentUsage(_ context.Context, _ string) (int64, error) {
+	return r.usage, nil
+}
+
+func TestAttachmentLimitsCheck(t *testing.T) {
+	existing := &shared.Attachment{ID: 1, FileName: "existing.txt", Size: 40}
+
+	upload := func(a *shared.Attachment) *shared.AttachmentUpload {
+		return &shared.AttachmentUpload{Attachment: a}
+	}
+
+	inserted := &shared.Attachment{FileName: "new.txt", Size: 30}
+	replaced := &shared.Attachment{ID: 1, FileName: "existing.txt", Size: 50}
+	shrunk := &shared.Attachment{ID: 1, FileName: "existing.txt", Size: 10}
+
+	tests := []struct {
+		name       string
+		limits     AttachmentLimits
+		update     *AttachmentsUpdate
+		usage      int64
+		wantKey    string
+		wantLocked bool
+	}{
+		{
+			name:    "file too large",
+			limits:  AttachmentLimits{MaxFileSize: 20},
+			update:  &AttachmentsUpdate{Inserted: []*shared.Attachment{inserted}, Uploads: []*shared.AttachmentUpload{upload(inserted)}},
+			wantKey: "attachment_file_too_large",
+		},
+		{
+			name:    "type not allowed",
+			limits:  AttachmentLimits{AllowedTypes: []string{".pdf"}},
+			update:  &AttachmentsUpdate{Inserted: []*shared.Attachment{inserted}, Uploads: []*shared.AttachmentUpload{upload(inserted)}},
+			wantKey: "attachment_type_not_allowed",
+		},
+		{
+			name:    "too many files",
+			limits:  AttachmentLimits{MaxTaskFiles: 1},
+			update:  &AttachmentsUpdate{Inserted: []*shared.Attachment{inserted}, Uploads: []*shared.AttachmentUpload{upload(inserted)}},
+			wantKey: "attachment_too_many_files",
+		},
+		{
+			name:   "deleting makes room for a new file",
+			limits: AttachmentLimits{MaxTaskFiles: 1},
+			update: &AttachmentsUpdate{Inserted: []*shared.Attachment{inserted}, Deleted: []*shared.Attachment{existing}, Uploads: []*shared.AttachmentUpload{upload(inserted)}},
+		},
+		{
+			name:       "new file within the quota",
+			limits:     AttachmentLimits{UserQuota: 100},
+			update:     &AttachmentsUpdate{Inserted: []*shared.Attachment{inserted}, Uploads: []*shared.AttachmentUpload{upload(inserted)}},
+			usage:      70,
+			wantLocked: true,
+		},
+		{
+			name:       "new file over the quota",
+			limits:     AttachmentLimits{UserQuota: 100},
+			update:     &AttachmentsUpdate{Inserted: []*shared.Attachment{inserted}, Uploads: []*shared.AttachmentUpload{upload(inserted)}},
+			usage:      71,
+			wantKey:    "attachment_quota_exceeded",
+			wantLocked: true,
+		},
+		{
+			name:       "replacement counts only the growth",
+			limits:     AttachmentLimits{UserQuota: 100},
+			update:     &AttachmentsUpdate{Updated: []*shared.Attachment{replaced}, Uploads: []*shared.AttachmentUpload{upload(replaced)}},
+			usage:      90,
+			wantLocked: true,
+		},
+		{
+			name:   "shrinking replacement over the quota is allowed",
+			limits: AttachmentLimits{UserQuota: 100},
+			update: &AttachmentsUpdate{Updated: []*shared.Attachment{shrunk}, Uploads: []*shared.AttachmentUpload{upload(shrunk)}},
+			usage:  200,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &shared.Task{ID: 1, UserID: "owner", Attachments: shared.Attachments{existing}}
+			repo := &fakeAttachmentUsageRepository{usage: tt.usage}
+
+			err := tt.limits.Check(t.Context(), shared.TxContext{TaskRepository: repo}, task, tt.update)
+
+			var key string
+			if limitErr, ok := err.(*AttachmentLimitError); ok {
+				key = limitErr.Key
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			if key != tt.wantKey {
+				t.Errorf("Check() = %v, want %q", err, tt.wantKey)
+			}
+			if repo.locked != tt.wantLocked {
+				t.Errorf("usage locked = %v, want %v", repo.locked, tt.wantLocked)
+			}
+		})
+	}
+}
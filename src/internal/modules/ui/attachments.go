@@ -1,37 +1,124 @@
 package ui
 
-import "tasks-app/internal/shared"
+import (
+	"fmt"
+	"mime/multipart"
+	"slices"
+	"strings"
+	"tasks-app/internal/shared"
+)
 
+// AttachmentsUpdate is a change to the attachments of a task. Replaced holds
+// the previous versions of the updated attachments, whose stored content is
+// deleted together with the deleted attachments once the update is committed.
 type AttachmentsUpdate struct {
-	Inserted []string
-	Deleted  map[int]string
+	Inserted []*shared.Attachment
+	Updated  []*shared.Attachment
+	Deleted  []*shared.Attachment
+	Replaced []*shared.Attachment
+	Uploads  []*shared.AttachmentUpload
 }
 
-func BuildAttachmentsUpdate(current []*shared.Attachment, updated []string) *AttachmentsUpdate {
-	var inserted []string
+// BuildAttachmentsUpdate compares the current attachments of a task with the
+// names of the attachments to keep and the uploaded files. An uploaded file
+// replaces the content of the attachment with the same name or is added as a
+// new attachment. Attachments that are neither kept nor uploaded are deleted.
+// The size, the MIME type and the checksum of the uploaded files are read
+// here, so that they are recorded with the attachments.
+func BuildAttachmentsUpdate(current []*shared.Attachment, names []string, files []*multipart.FileHeader) (*AttachmentsUpdate, error) {
+	var update AttachmentsUpdate
+
+	uploads := make(map[string]*shared.AttachmentUpload)
+	for _, file := range files {
+		uploads[file.Filename] = &shared.AttachmentUpload{File: file}
+	}
 
-	deleted := make(map[int]string)
 	for _, c := range current {
-		deleted[c.ID] = c.FileName
+		if upload, ok := uploads[c.FileName]; ok {
+			upload.Attachment = c.Replace()
+
+			update.Updated = append(update.Updated, upload.Attachment)
+			update.Replaced = append(update.Replaced, c)
+		} else if !slices.Contains(names, c.FileName) {
+			update.Deleted = append(update.Deleted, c)
+		}
 	}
 
-	for _, uName := range updated {
-		var id int
-		var exists bool
-		for cID, cName := range deleted {
-			if cName == uName {
-				exists = true
-				id = cID
-				break
-			}
+	for _, file := range files {
+		upload := uploads[file.Filename]
+		if upload.File != file {
+			continue
+		}
+
+		if upload.Attachment == nil {
+			upload.Attachment = shared.NewAttachment(file.Filename)
+			update.Inserted = append(update.Inserted, upload.Attachment)
+		}
+
+		if err := setAttachmentContent(upload.Attachment, file); err != nil {
+			return nil, err
 		}
 
-		if exists {
-			delete(deleted, id)
+		update.Uploads = append(update.Uploads, upload)
+	}
+
+	return &update, nil
+}
+
+// Obsolete returns the attachments whose stored content is no longer used
+// after the update.
+func (u *AttachmentsUpdate) Obsolete() []*shared.Attachment {
+	return slices.Concat(u.Deleted, u.Replaced)
+}
+
+func setAttachmentContent(a *shared.Attachment, file *multipart.FileHeader) error {
+	f, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return a.SetContent(f)
+}
+
+// FindAttachment returns the attachment of a task with the given name, or nil
+// if there is none.
+func FindAttachment(task *shared.Task, name string) *shared.Attachment {
+	i := slices.IndexFunc(task.Attachments, func(a *shared.Attachment) bool {
+		return a.FileName == name
+	})
+	if i < 0 {
+		return nil
+	}
+
+	return task.Attachments[i]
+}
+
+// ContentDisposition returns the Content-Disposition header for downloading a
+// file. The name is given as a quoted ASCII fallback and in the RFC 5987
+// extended notation, which clients that support it prefer.
+func ContentDisposition(name string) string {
+	var fallback, encoded strings.Builder
+
+	for _, r := range name {
+		if r < 0x20 || 0x7e < r || r == '"' || r == '\\' {
+			fallback.WriteByte('_')
 		} else {
-			inserted = append(inserted, uName)
+			fallback.WriteRune(r)
 		}
 	}
 
-	return &AttachmentsUpdate{inserted, deleted}
+	for _, b := range []byte(name) {
+		if isRFC5987AttrChar(b) {
+			encoded.WriteByte(b)
+		} else {
+			fmt.Fprintf(&encoded, "%%%02X", b)
+		}
+	}
+
+	return fmt.Sprintf(`attachment; filename="%s"; filename*=UTF-8''%s`, fallback.String(), encoded.String())
+}
+
+func isRFC5987AttrChar(b byte) bool {
+	return 'a' <= b && b <= 'z' || 'A' <= b && b <= 'Z' || '0' <= b && b <= '9' || strings.IndexByte("!#$&+-.^_`|~", b) >= 0
 }
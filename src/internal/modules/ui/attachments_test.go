@@ -0,0 +1,128 @@
+package ui
+
+import (
+	"bytes"
+	"mime/multipart"
+	"slices"
+	"tasks-app/internal/shared"
+	"testing"
+)
+
+func TestBuildAttachmentsUpdate(t *testing.T) {
+	kept := &shared.Attachment{ID: 1, FileName: "kept.txt", StorageKey: "KEPT"}
+	replaced := &shared.Attachment{ID: 2, FileName: "replaced.txt", StorageKey: "REPLACED", Size: 3}
+	deleted := &shared.Attachment{ID: 3, FileName: "deleted.txt", StorageKey: "DELETED"}
+
+	files := multipartFiles(t, map[string]string{
+		"replaced.txt": "new content",
+		"added.txt":    "added",
+	})
+
+	update, err := BuildAttachmentsUpdate(
+		[]*shared.Attachment{kept, replaced, deleted},
+		[]string{"kept.txt", "replaced.txt"},
+		files,
+	)
+	if err != nil {
+		t.Fatalf("BuildAttachmentsUpdate() error = %v", err)
+	}
+
+	if len(update.Inserted) != 1 || update.Inserted[0].FileName != "added.txt" {
+		t.Errorf("Inserted = %v, want added.txt", update.Inserted)
+	}
+	if len(update.Updated) != 1 {
+		t.Fatalf("Updated = %v, want replaced.txt", update.Updated)
+	}
+
+	u := update.Updated[0]
+	if u.ID != replaced.ID || u.StorageKey == replaced.StorageKey || u.Size != int64(len("new content")) {
+		t.Errorf("Updated = %+v, want a new storage key and the new size", u)
+	}
+	if replaced.StorageKey != "REPLACED" || replaced.Size != 3 {
+		t.Errorf("current attachment = %+v, want it unchanged", replaced)
+	}
+	if !slices.Equal(update.Deleted, []*shared.Attachment{deleted}) {
+		t.Errorf("Deleted = %v, want deleted.txt", update.Deleted)
+	}
+	if !slices.Equal(update.Obsolete(), []*shared.Attachment{deleted, replaced}) {
+		t.Errorf("Obsolete() = %v, want deleted.txt and the replaced version", update.Obsolete())
+	}
+	if len(update.Uploads) != 2 {
+		t.Errorf("Uploads = %v, want 2", update.Uploads)
+	}
+}
+
+func multipartFiles(t *testing.T, contents map[string]string) []*multipart.FileHeader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+
+	for name, content := range contents {
+		fw, err := w.CreateFormFile("attachments", name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fw.Write([]byte(content))
+	}
+	w.Close()
+
+	form, err := multipart.NewReader(&buf, w.Boundary()).ReadForm(1 << 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return form.File["attachments"]
+}
+
+func TestContentDisposition(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		want string
+	}{
+		{
+			name: "ascii",
+			file: "report.pdf",
+			want: `attachment; filename="report.pdf"; filename*=UTF-8''report.pdf`,
+		},
+		{
+			name: "space",
+			file: "annual report.pdf",
+			want: `attachment; filename="annual report.pdf"; filename*=UTF-8''annual%20report.pdf`,
+		},
+		{
+			name: "non-ascii",
+			file: "kesä.txt",
+			want: `attachment; filename="kes_.txt"; filename*=UTF-8''kes%C3%A4.txt`,
+		},
+		{
+			name: "quote and backslash",
+			file: `a"b\c.txt`,
+			want: `attachment; filename="a_b_c.txt"; filename*=UTF-8''a%22b%5Cc.txt`,
+		},
+		{
+			name: "control character",
+			file: "a\r\nb.txt",
+			want: `attachment; filename="a__b.txt"; filename*=UTF-8''a%0D%0Ab.txt`,
+		},
+		{
+			name: "attribute characters",
+			file: "a!#$&+-.^_`|~b",
+			want: "attachment; filename=\"a!#$&+-.^_`|~b\"; filename*=UTF-8''a!#$&+-.^_`|~b",
+		},
+		{
+			name: "separators",
+			file: "a;b=c%d",
+			want: `attachment; filename="a;b=c%d"; filename*=UTF-8''a%3Bb%3Dc%25d`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ContentDisposition(tt.file); got != tt.want {
+				t.Errorf("ContentDisposition(%q) = %q, want %q", tt.file, got, tt.want)
+			}
+		})
+	}
+}
@@ -58,11 +58,12 @@ func NewAuth(ctx context.Context, conn *nats.Conn, config *shared.Config) (*Auth
 func (a *Auth) GetUserContext(r *http.Request) *shared.UserContext {
 	if ctx := a.Middleware.Context(r.Context()); ctx != nil {
 		return &shared.UserContext{
-			ID:          ctx.UserInfo.Subject,
-			Name:        ctx.UserInfo.Name,
-			Email:       ctx.UserInfo.Email,
-			IDToken:     ctx.Tokens.IDToken,
-			AccessToken: ctx.Tokens.AccessToken,
+			ID:            ctx.UserInfo.Subject,
+			Name:          ctx.UserInfo.Name,
+			Email:         ctx.UserInfo.Email,
+			EmailVerified: bool(ctx.UserInfo.EmailVerified),
+			IDToken:       ctx.Tokens.IDToken,
+			AccessToken:   ctx.Tokens.AccessToken,
 		}
 	}
 	return nil
@@ -0,0 +1,32 @@
+package ui
+
+import (
+	"context"
+	"tasks-app/internal/shared"
+)
+
+const TaskBlockerCandidatesLimit = 500
+
+// GetTaskBlockerCandidates returns the active tasks of the owner that can be
+// added as blockers of the task, or nil when the current user does not own
+// the task. Tasks that would create a cycle are refused when added.
+func GetTaskBlockerCandidates(ctx context.Context, txc shared.TxContext, task *shared.Task) ([]*shared.Task, error) {
+	if err := shared.AuthorizeTask(ctx, task, shared.TaskRoleOwner); err != nil {
+		return nil, nil
+	}
+
+	page, err := txc.TaskRepository.GetActive(ctx, shared.TaskFilter{}, shared.TaskSortName, nil, TaskBlockerCandidatesLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []*shared.Task
+
+	for _, t := range page.Tasks {
+		if t.ID != task.ID && t.UserID == task.UserID && !task.Blockers.Contains(t.ID) {
+			candidates = append(candidates, t)
+		}
+	}
+
+	return candidates, nil
+}
@@ -0,0 +1,50 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"tasks-app/internal/shared"
+)
+
+const (
+	BulkActionComplete   = "complete"
+	BulkActionDelete     = "delete"
+	BulkActionReschedule = "reschedule"
+	BulkActionTag        = "tag"
+	BulkActionMove       = "move"
+)
+
+var SupportedBulkActions = []string{
+	BulkActionComplete,
+	BulkActionDelete,
+	BulkActionReschedule,
+	BulkActionTag,
+	BulkActionMove,
+}
+
+const BulkTasksMax = 500
+
+// BulkTaskError is the failure of a bulk action on one of the selected tasks.
+type BulkTaskError struct {
+	Task *shared.Task
+	Err  error
+}
+
+func (e *BulkTaskError) Error() string {
+	switch e.Err {
+	case shared.ErrForbidden:
+		return fmt.Sprintf("%s: cannot be modified", e.Task.Name)
+	case shared.ErrBlocked:
+		names := make([]string, 0, len(e.Task.Blockers))
+		for _, b := range e.Task.Blockers.Open() {
+			names = append(names, b.Name)
+		}
+		return fmt.Sprintf("%s: blocked by open tasks: %s", e.Task.Name, strings.Join(names, ", "))
+	default:
+		return fmt.Sprintf("%s: %v", e.Task.Name, e.Err)
+	}
+}
+
+func (e *BulkTaskError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,65 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type DeleteAPITask struct {
+	TxManager shared.TxManager
+	Logger    *slog.Logger
+}
+
+func (h *DeleteAPITask) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseTaskRequest(r)
+	if err != nil {
+		WriteProblem(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		task, err := txc.TaskRepository.GetByID(r.Context(), req.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := shared.AuthorizeTask(r.Context(), task, shared.TaskRoleOwner); err != nil {
+			return err
+		}
+
+		if !IfMatch(r, TaskETag(task)) {
+			return ErrPreconditionFailed
+		}
+
+		task.SetDeleted()
+
+		if err := txc.TaskRepository.Update(r.Context(), task); err != nil {
+			return err
+		}
+
+		if err := shared.UnblockDependents(r.Context(), txc, task); err != nil {
+			return err
+		}
+
+		return txc.TaskEventRepository.Create(r.Context(), shared.NewTaskEvent(task.ID, shared.TaskEventDeleted, shared.TaskEventData{}))
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			WriteProblem(w, http.StatusNotFound, "task not found")
+		} else if err == shared.ErrForbidden {
+			WriteProblem(w, http.StatusForbidden, "task cannot be modified")
+		} else if err == ErrPreconditionFailed {
+			WriteProblem(w, http.StatusPreconditionFailed, "task has been modified")
+		} else if err == shared.ErrConflict {
+			WriteProblem(w, http.StatusConflict, "task was modified concurrently")
+		} else {
+			h.Logger.Error("delete task", "error", err)
+			WriteProblem(w, http.StatusInternalServerError, "")
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,65 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type DeleteAPITaskAttachment struct {
+	TxManager                 shared.TxManager
+	TaskAttachmentsRepository shared.TaskAttachmentsRepository
+	Logger                    *slog.Logger
+}
+
+func (h *DeleteAPITaskAttachment) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseTaskAttachmentRequest(r)
+	if err != nil {
+		WriteProblem(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var task *shared.Task
+	var deleted []*shared.Attachment
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		if task, err = txc.TaskRepository.GetByID(r.Context(), req.ID); err != nil {
+			return err
+		}
+
+		if err := shared.AuthorizeTask(r.Context(), task, shared.TaskRoleOwner, shared.TaskRoleEditor); err != nil {
+			return err
+		}
+
+		attachment := FindAttachment(task, req.Name)
+		if attachment == nil {
+			return shared.ErrNotFound
+		}
+
+		deleted = []*shared.Attachment{attachment}
+
+		if err := txc.TaskRepository.UpdateAttachments(r.Context(), task.ID, nil, nil, deleted); err != nil {
+			return err
+		}
+
+		return txc.TaskEventRepository.Create(r.Context(), shared.NewTaskAttachmentEvents(task.ID, nil, deleted)...)
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			WriteProblem(w, http.StatusNotFound, "task or task attachment not found")
+		} else if err == shared.ErrForbidden {
+			WriteProblem(w, http.StatusForbidden, "task cannot be modified")
+		} else {
+			h.Logger.Error("delete task attachment", "error", err)
+			WriteProblem(w, http.StatusInternalServerError, "")
+		}
+		return
+	}
+
+	if err := h.TaskAttachmentsRepository.DeleteAttachments(r.Context(), task.ID, deleted); err != nil {
+		h.Logger.Error("delete task attachment", "error", err)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
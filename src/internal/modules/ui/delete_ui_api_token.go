@@ -0,0 +1,54 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type DeleteUIAPIToken struct {
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
+}
+
+func (h *DeleteUIAPIToken) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseAPITokenRequest(r)
+	if err != nil {
+		h.Logger.Error("parse request", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var tokens []*shared.APIToken
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		token, err := txc.APITokenRepository.GetByID(r.Context(), req.ID)
+		if err != nil {
+			return err
+		}
+
+		token.SetRevoked()
+
+		if err := txc.APITokenRepository.Update(r.Context(), token); err != nil {
+			return err
+		}
+
+		tokens, err = txc.APITokenRepository.GetAll(r.Context())
+		return err
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			http.Error(w, "api token not found", http.StatusNotFound)
+		} else {
+			h.Logger.Error("revoke api token", "error", err)
+			http.Error(w, "", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	vm := NewAPITokensResponse(r, tokens)
+
+	h.Renderer.Render(w, "api_tokens_table.html", vm)
+}
@@ -0,0 +1,55 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type DeleteUIProject struct {
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
+}
+
+func (h *DeleteUIProject) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseProjectRequest(r)
+	if err != nil {
+		h.Logger.Error("parse request", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var projects []*shared.Project
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		if _, err := txc.ProjectRepository.GetByID(r.Context(), req.ID); err != nil {
+			return err
+		}
+
+		if err := txc.ProjectRepository.Delete(r.Context(), req.ID); err != nil {
+			return err
+		}
+
+		projects, err = txc.ProjectRepository.GetAll(r.Context())
+		return err
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			http.Error(w, "project not found", http.StatusNotFound)
+		} else {
+			h.Logger.Error("delete project", "error", err)
+			http.Error(w, "", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	if req.ID == GetProject(r) {
+		SetProjectCookie(w, 0)
+	}
+
+	vm := NewProjectsResponse(r, projects)
+
+	h.Renderer.Render(w, "projects_table.html", vm)
+}
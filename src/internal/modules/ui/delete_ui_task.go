@@ -7,10 +7,9 @@ import (
 )
 
 type DeleteUITask struct {
-	TxManager                 shared.TxManager
-	TaskAttachmentsRepository shared.TaskAttachmentsRepository
-	Renderer                  Renderer
-	Logger                    *slog.Logger
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
 }
 
 func (h *DeleteUITask) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -22,20 +21,33 @@ func (h *DeleteUITask) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
-		if _, err := txc.TaskRepository.GetByID(r.Context(), req.ID); err != nil {
+		task, err := txc.TaskRepository.GetByID(r.Context(), req.ID)
+		if err != nil {
 			return err
 		}
 
-		if err = txc.TaskRepository.Delete(r.Context(), req.ID); err != nil {
+		if err := shared.AuthorizeTask(r.Context(), task, shared.TaskRoleOwner); err != nil {
 			return err
 		}
 
-		return h.TaskAttachmentsRepository.DeleteTask(r.Context(), req.ID)
+		task.SetDeleted()
+
+		if err := txc.TaskRepository.Update(r.Context(), task); err != nil {
+			return err
+		}
+
+		if err := shared.UnblockDependents(r.Context(), txc, task); err != nil {
+			return err
+		}
+
+		return txc.TaskEventRepository.Create(r.Context(), shared.NewTaskEvent(task.ID, shared.TaskEventDeleted, shared.TaskEventData{}))
 	})
 
 	if err != nil {
 		if err == shared.ErrNotFound {
 			http.Error(w, "task not found", http.StatusNotFound)
+		} else if err == shared.ErrForbidden {
+			http.Error(w, "task cannot be modified", http.StatusForbidden)
 		} else {
 			h.Logger.Error("delete task", "error", err)
 			http.Error(w, "", http.StatusInternalServerError)
@@ -43,10 +55,10 @@ func (h *DeleteUITask) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var tasks []*shared.Task
+	var page *shared.TaskPage
 
 	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
-		tasks, err = txc.TaskRepository.GetActive(r.Context(), 0, 50)
+		page, err = txc.TaskRepository.GetActive(r.Context(), GetTaskFilter(r), GetActiveSort(r), nil, TasksPageSize)
 		return err
 	})
 
@@ -56,7 +68,7 @@ func (h *DeleteUITask) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	vm := NewTasksResponse(r, tasks)
+	vm := NewTasksResponse(r, page)
 
 	h.Renderer.Render(w, "active_tasks_table.html", vm)
 }
@@ -0,0 +1,72 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type DeleteUITaskBlocker struct {
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
+}
+
+func (h *DeleteUITaskBlocker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseTaskBlockerRequest(r)
+	if err != nil {
+		h.Logger.Error("parse request", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var task *shared.Task
+	var candidates []*shared.Task
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		if task, err = txc.TaskRepository.GetByID(r.Context(), req.TaskID); err != nil {
+			return err
+		}
+
+		if err := shared.AuthorizeTask(r.Context(), task, shared.TaskRoleOwner); err != nil {
+			return err
+		}
+
+		for _, b := range task.Blockers {
+			if b.ID != req.BlockerID {
+				continue
+			}
+
+			if err := txc.TaskBlockerRepository.Delete(r.Context(), task.ID, b.ID); err != nil {
+				return err
+			}
+
+			if err := txc.TaskEventRepository.Create(r.Context(), shared.NewTaskEvent(task.ID, shared.TaskEventBlockerRemoved, shared.TaskEventData{Blocker: b.Name})); err != nil {
+				return err
+			}
+		}
+
+		if task, err = txc.TaskRepository.GetByID(r.Context(), req.TaskID); err != nil {
+			return err
+		}
+
+		candidates, err = GetTaskBlockerCandidates(r.Context(), txc, task)
+		return err
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			http.Error(w, "task not found", http.StatusNotFound)
+		} else if err == shared.ErrForbidden {
+			http.Error(w, "task cannot be modified", http.StatusForbidden)
+		} else {
+			h.Logger.Error("delete task blocker", "error", err)
+			http.Error(w, "", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	vm := NewTaskBlockersResponse(r, task, candidates)
+
+	h.Renderer.Render(w, "task_blockers_list.html", vm)
+}
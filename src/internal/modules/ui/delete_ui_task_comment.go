@@ -0,0 +1,63 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type DeleteUITaskComment struct {
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
+}
+
+func (h *DeleteUITaskComment) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseTaskCommentRequest(r)
+	if err != nil {
+		h.Logger.Error("parse request", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var task *shared.Task
+	var comments []*shared.TaskComment
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		if task, err = txc.TaskRepository.GetByID(r.Context(), req.TaskID); err != nil {
+			return err
+		}
+
+		comment, err := txc.TaskCommentRepository.GetByID(r.Context(), req.TaskID, req.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := shared.AuthorizeTaskComment(r.Context(), comment); err != nil {
+			return err
+		}
+
+		if err := txc.TaskCommentRepository.Delete(r.Context(), req.TaskID, req.ID); err != nil {
+			return err
+		}
+
+		comments, err = txc.TaskCommentRepository.GetByTaskID(r.Context(), req.TaskID)
+		return err
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			http.Error(w, "comment not found", http.StatusNotFound)
+		} else if err == shared.ErrForbidden {
+			http.Error(w, "comment cannot be modified", http.StatusForbidden)
+		} else {
+			h.Logger.Error("delete task comment", "error", err)
+			http.Error(w, "", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	vm := NewTaskCommentsResponse(r, task, comments)
+
+	h.Renderer.Render(w, "task_comments_list.html", vm)
+}
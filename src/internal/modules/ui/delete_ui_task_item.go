@@ -0,0 +1,57 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type DeleteUITaskItem struct {
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
+}
+
+func (h *DeleteUITaskItem) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseTaskItemRequest(r)
+	if err != nil {
+		h.Logger.Error("parse request", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var task *shared.Task
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		if task, err = txc.TaskRepository.GetByID(r.Context(), req.TaskID); err != nil {
+			return err
+		}
+
+		if err := shared.AuthorizeTask(r.Context(), task, shared.TaskRoleOwner, shared.TaskRoleEditor); err != nil {
+			return err
+		}
+
+		if err := txc.TaskItemRepository.Delete(r.Context(), req.TaskID, req.ID); err != nil {
+			return err
+		}
+
+		task, err = txc.TaskRepository.GetByID(r.Context(), req.TaskID)
+		return err
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			http.Error(w, "task not found", http.StatusNotFound)
+		} else if err == shared.ErrForbidden {
+			http.Error(w, "task cannot be modified", http.StatusForbidden)
+		} else {
+			h.Logger.Error("delete task item", "error", err)
+			http.Error(w, "", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	vm := NewTaskItemsResponse(r, task)
+
+	h.Renderer.Render(w, "task_items.html", vm)
+}
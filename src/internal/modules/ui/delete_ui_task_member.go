@@ -0,0 +1,71 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"slices"
+	"tasks-app/internal/shared"
+)
+
+type DeleteUITaskMember struct {
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
+}
+
+func (h *DeleteUITaskMember) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseTaskMemberRequest(r)
+	if err != nil {
+		h.Logger.Error("parse request", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var task *shared.Task
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		if task, err = txc.TaskRepository.GetByID(r.Context(), req.TaskID); err != nil {
+			return err
+		}
+
+		if err := shared.AuthorizeTask(r.Context(), task, shared.TaskRoleOwner); err != nil {
+			return err
+		}
+
+		i := slices.IndexFunc(task.Members, func(m *shared.TaskMember) bool { return m.ID == req.ID })
+		if i < 0 {
+			return shared.ErrNotFound
+		}
+
+		member := task.Members[i]
+
+		if err := txc.TaskMemberRepository.Delete(r.Context(), req.TaskID, req.ID); err != nil {
+			return err
+		}
+
+		event := shared.NewTaskEvent(task.ID, shared.TaskEventUnshared, shared.TaskEventData{Member: member.Identity(), Role: member.Role})
+
+		if err := txc.TaskEventRepository.Create(r.Context(), event); err != nil {
+			return err
+		}
+
+		task, err = txc.TaskRepository.GetByID(r.Context(), req.TaskID)
+		return err
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			http.Error(w, "task member not found", http.StatusNotFound)
+		} else if err == shared.ErrForbidden {
+			http.Error(w, "only the owner can share the task", http.StatusForbidden)
+		} else {
+			h.Logger.Error("delete task member", "error", err)
+			http.Error(w, "", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	vm := NewTaskMembersResponse(r, task)
+
+	h.Renderer.Render(w, "task_members.html", vm)
+}
@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type DeleteUITrash struct {
+	TxManager                 shared.TxManager
+	TaskAttachmentsRepository shared.TaskAttachmentsRepository
+	Renderer                  Renderer
+	Logger                    *slog.Logger
+}
+
+func (h *DeleteUITrash) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var ids []int
+	var err error
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		ids, err = txc.TaskRepository.PurgeDeleted(r.Context(), 0)
+		return err
+	})
+
+	if err != nil {
+		h.Logger.Error("empty trash", "error", err)
+		http.Error(w, "", http.StatusInternalServerError)
+		return
+	}
+
+	// The files are deleted once the tasks are gone. A file that cannot be
+	// deleted is only left orphaned.
+	for _, id := range ids {
+		if err := h.TaskAttachmentsRepository.DeleteTask(r.Context(), id); err != nil {
+			h.Logger.Error("delete task attachments", "error", err)
+		}
+	}
+
+	vm := NewTasksResponse(r, &shared.TaskPage{})
+
+	h.Renderer.Render(w, "trash_table.html", vm)
+}
@@ -0,0 +1,30 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type GetAPIProjects struct {
+	TxManager shared.TxManager
+	Logger    *slog.Logger
+}
+
+func (h *GetAPIProjects) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var projects []*shared.Project
+	var err error
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		projects, err = txc.ProjectRepository.GetAll(r.Context())
+		return err
+	})
+
+	if err != nil {
+		h.Logger.Error("get projects", "error", err)
+		WriteProblem(w, http.StatusInternalServerError, "")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, NewAPIProjectsResponse(projects))
+}
@@ -0,0 +1,39 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type GetAPITask struct {
+	TxManager shared.TxManager
+	Logger    *slog.Logger
+}
+
+func (h *GetAPITask) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseTaskRequest(r)
+	if err != nil {
+		WriteProblem(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var task *shared.Task
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		task, err = txc.TaskRepository.GetByID(r.Context(), req.ID)
+		return err
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			WriteProblem(w, http.StatusNotFound, "task not found")
+		} else {
+			h.Logger.Error("get task", "error", err)
+			WriteProblem(w, http.StatusInternalServerError, "")
+		}
+		return
+	}
+
+	WriteTaskJSON(w, http.StatusOK, task)
+}
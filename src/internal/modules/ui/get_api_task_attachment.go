@@ -0,0 +1,67 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type GetAPITaskAttachment struct {
+	TxManager                 shared.TxManager
+	TaskAttachmentsRepository shared.TaskAttachmentsRepository
+	Logger                    *slog.Logger
+}
+
+func (h *GetAPITaskAttachment) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseTaskAttachmentRequest(r)
+	if err != nil {
+		WriteProblem(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var task *shared.Task
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		task, err = txc.TaskRepository.GetByID(r.Context(), req.ID)
+		return err
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			WriteProblem(w, http.StatusNotFound, "task not found")
+		} else {
+			h.Logger.Error("get task", "error", err)
+			WriteProblem(w, http.StatusInternalServerError, "")
+		}
+		return
+	}
+
+	attachment := FindAttachment(task, req.Name)
+	if attachment == nil {
+		WriteProblem(w, http.StatusNotFound, "task attachment not found")
+		return
+	}
+
+	content, err := h.TaskAttachmentsRepository.GetAttachment(r.Context(), task.ID, attachment)
+	if err != nil {
+		if err == shared.ErrNotFound {
+			WriteProblem(w, http.StatusNotFound, "task attachment not found")
+		} else {
+			h.Logger.Error("get task attachment", "error", err)
+			WriteProblem(w, http.StatusInternalServerError, "")
+		}
+		return
+	}
+	defer content.Close()
+
+	if content.ContentType != "" {
+		w.Header().Set("Content-Type", content.ContentType)
+	}
+	w.Header().Set("Content-Disposition", ContentDisposition(attachment.FileName))
+
+	http.ServeContent(w, r, attachment.FileName, content.ModTime, content)
+
+	if err := content.Err(); err != nil {
+		h.Logger.Error("verify task attachment", "error", err)
+	}
+}
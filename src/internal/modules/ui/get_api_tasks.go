@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type GetAPITasks struct {
+	TxManager shared.TxManager
+	Logger    *slog.Logger
+}
+
+func (h *GetAPITasks) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseAPITasksRequest(r)
+	if err != nil {
+		WriteProblem(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	filter := shared.TaskFilter{Tag: req.Tag, ProjectID: req.Project, AssignedToMe: req.Assigned}
+
+	var page *shared.TaskPage
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		switch req.Filter {
+		case TaskFilterCompleted:
+			page, err = txc.TaskRepository.GetCompleted(r.Context(), filter, req.Sort, req.Cursor, req.Limit)
+		default:
+			page, err = txc.TaskRepository.GetActive(r.Context(), filter, req.Sort, req.Cursor, req.Limit)
+		}
+		return err
+	})
+
+	if err != nil {
+		h.Logger.Error("get tasks", "error", err)
+		WriteProblem(w, http.StatusInternalServerError, "")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, NewAPITasksResponse(page))
+}
@@ -0,0 +1,69 @@
+package ui
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type GetAPITasksExport struct {
+	TxManager    shared.TxManager
+	FileExporter shared.FileExporter
+	Logger       *slog.Logger
+}
+
+func (h *GetAPITasksExport) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	project, err := ParseTaskProjectFilter(r.FormValue("project"))
+	if err != nil {
+		WriteProblem(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	assigned, err := ParseTaskAssignedFilter(r.FormValue("assigned"))
+	if err != nil {
+		WriteProblem(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	filter := shared.TaskFilter{Tag: GetTagFilter(r), ProjectID: project, AssignedToMe: assigned}
+
+	var name string
+	var tasks []*shared.Task
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		active := func(cursor *shared.TaskCursor) (*shared.TaskPage, error) {
+			return txc.TaskRepository.GetActive(r.Context(), filter, shared.TaskSortCreated, cursor, TasksExportPageSize)
+		}
+		completed := func(cursor *shared.TaskCursor) (*shared.TaskPage, error) {
+			return txc.TaskRepository.GetCompleted(r.Context(), filter, shared.TaskSortCompleted, cursor, TasksExportPageSize)
+		}
+
+		switch r.FormValue("filter") {
+		case TaskFilterActive:
+			name = "active_tasks"
+			tasks, err = GetAllTasks(active)
+		case TaskFilterCompleted:
+			name = "completed_tasks"
+			tasks, err = GetAllTasks(completed)
+		default:
+			name = "all_tasks"
+			tasks1, err1 := GetAllTasks(active)
+			tasks2, err2 := GetAllTasks(completed)
+			tasks, err = append(tasks1, tasks2...), errors.Join(err1, err2)
+		}
+
+		return err
+	})
+
+	if err != nil {
+		h.Logger.Error("get tasks", "error", err)
+		WriteProblem(w, http.StatusInternalServerError, "")
+		return
+	}
+
+	if err = h.FileExporter.ExportTasks(w, tasks, name); err != nil {
+		h.Logger.Error("export tasks", "error", err)
+		WriteProblem(w, http.StatusInternalServerError, "")
+	}
+}
@@ -0,0 +1,35 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type GetAPITasksSearch struct {
+	TxManager shared.TxManager
+	Logger    *slog.Logger
+}
+
+func (h *GetAPITasksSearch) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseAPITasksSearchRequest(r)
+	if err != nil {
+		WriteProblem(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var results []*shared.TaskSearchResult
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		results, err = txc.TaskRepository.Search(r.Context(), req.Query, req.Language, req.Offset, req.Limit)
+		return err
+	})
+
+	if err != nil {
+		h.Logger.Error("search tasks", "error", err)
+		WriteProblem(w, http.StatusInternalServerError, "")
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, NewAPITasksSearchResponse(results))
+}
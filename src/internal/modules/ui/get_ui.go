@@ -13,11 +13,18 @@ type GetUI struct {
 }
 
 func (h *GetUI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	var tasks []*shared.Task
+	sort := GetActiveSort(r)
+
+	var page *shared.TaskPage
+	var tags []*shared.Tag
 	var err error
 
 	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
-		tasks, err = txc.TaskRepository.GetActive(r.Context(), 0, 50)
+		if page, err = txc.TaskRepository.GetActive(r.Context(), GetTaskFilter(r), sort, nil, TasksPageSize); err != nil {
+			return err
+		}
+
+		tags, err = txc.TaskRepository.GetTags(r.Context())
 		return err
 	})
 
@@ -27,8 +34,14 @@ func (h *GetUI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	vm := NewTasksResponse(r, tasks)
+	vm := NewTasksResponse(r, page)
+	vm.Tags = tags
 	vm.UI.Title = "Active"
+	if vm.AssignedToMe {
+		vm.UI.Title = "Assigned"
+	}
+	vm.Sort = sort
+	vm.Sorts = shared.SupportedActiveTaskSorts
 
 	h.Renderer.Render(w, "active_tasks.html", vm)
 }
@@ -0,0 +1,34 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type GetUIAPITokens struct {
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
+}
+
+func (h *GetUIAPITokens) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var tokens []*shared.APIToken
+	var err error
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		tokens, err = txc.APITokenRepository.GetAll(r.Context())
+		return err
+	})
+
+	if err != nil {
+		h.Logger.Error("get api tokens", "error", err)
+		http.Error(w, "", http.StatusInternalServerError)
+		return
+	}
+
+	vm := NewAPITokensResponse(r, tokens)
+	vm.UI.Title = "API Tokens"
+
+	h.Renderer.Render(w, "api_tokens.html", vm)
+}
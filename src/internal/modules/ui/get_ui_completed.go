@@ -13,11 +13,18 @@ type GetUICompleted struct {
 }
 
 func (h *GetUICompleted) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	var tasks []*shared.Task
+	sort := GetCompletedSort(r)
+
+	var page *shared.TaskPage
+	var tags []*shared.Tag
 	var err error
 
 	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
-		tasks, err = txc.TaskRepository.GetCompleted(r.Context(), 0, 50)
+		if page, err = txc.TaskRepository.GetCompleted(r.Context(), GetTaskFilter(r), sort, nil, TasksPageSize); err != nil {
+			return err
+		}
+
+		tags, err = txc.TaskRepository.GetTags(r.Context())
 		return err
 	})
 
@@ -27,8 +34,11 @@ func (h *GetUICompleted) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	vm := NewTasksResponse(r, tasks)
+	vm := NewTasksResponse(r, page)
+	vm.Tags = tags
 	vm.UI.Title = "Completed"
+	vm.Sort = sort
+	vm.Sorts = shared.SupportedCompletedTaskSorts
 
 	h.Renderer.Render(w, "completed_tasks.html", vm)
 }
@@ -13,11 +13,29 @@ type GetUICompletedTasks struct {
 }
 
 func (h *GetUICompletedTasks) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	var tasks []*shared.Task
-	var err error
+	sort := GetCompletedSort(r)
+
+	if r.FormValue("sort") != "" {
+		req, err := ParseSortRequest(r, shared.SupportedCompletedTaskSorts)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		sort = req.Sort
+		SetSortCookie(w, CookieNameCompletedSort, sort)
+	}
+
+	cursor, err := ParseTaskCursor(r.FormValue("cursor"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var page *shared.TaskPage
 
 	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
-		tasks, err = txc.TaskRepository.GetCompleted(r.Context(), 0, 50)
+		page, err = txc.TaskRepository.GetCompleted(r.Context(), GetTaskFilter(r), sort, cursor, TasksPageSize)
 		return err
 	})
 
@@ -27,7 +45,12 @@ func (h *GetUICompletedTasks) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	vm := NewTasksResponse(r, tasks)
+	vm := NewTasksResponse(r, page)
+
+	if cursor != nil {
+		h.Renderer.Render(w, "completed_tasks_table_rows.html", vm)
+		return
+	}
 
 	h.Renderer.Render(w, "completed_tasks_table.html", vm)
 }
@@ -0,0 +1,34 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type GetUIProjects struct {
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
+}
+
+func (h *GetUIProjects) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var projects []*shared.Project
+	var err error
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		projects, err = txc.ProjectRepository.GetAll(r.Context())
+		return err
+	})
+
+	if err != nil {
+		h.Logger.Error("get projects", "error", err)
+		http.Error(w, "", http.StatusInternalServerError)
+		return
+	}
+
+	vm := NewProjectsResponse(r, projects)
+	vm.UI.Title = "Projects"
+
+	h.Renderer.Render(w, "projects.html", vm)
+}
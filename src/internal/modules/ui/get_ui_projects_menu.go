@@ -0,0 +1,43 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type GetUIProjectsMenu struct {
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
+}
+
+func (h *GetUIProjectsMenu) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var projects []*shared.Project
+	var err error
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		projects, err = txc.ProjectRepository.GetAll(r.Context())
+		return err
+	})
+
+	if err != nil {
+		h.Logger.Error("get projects", "error", err)
+		http.Error(w, "", http.StatusInternalServerError)
+		return
+	}
+
+	vm := NewProjectsResponse(r, projects)
+
+	// The selected project may have been deleted in another session.
+	if vm.Project == nil && vm.UI.ProjectID != 0 {
+		SetProjectCookie(w, 0)
+	}
+
+	if r.FormValue("view") == "move" {
+		h.Renderer.Render(w, "tasks_bulk_move.html", vm)
+		return
+	}
+
+	h.Renderer.Render(w, "navbar_projects.html", vm)
+}
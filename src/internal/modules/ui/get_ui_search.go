@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type GetUISearch struct {
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
+}
+
+func (h *GetUISearch) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseSearchRequest(r)
+	if err != nil {
+		h.Logger.Error("parse request", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var results []*shared.TaskSearchResult
+
+	if req.Query != "" {
+		err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+			results, err = txc.TaskRepository.Search(r.Context(), req.Query, GetLanguage(r), 0, 50)
+			return err
+		})
+	}
+
+	if err != nil {
+		h.Logger.Error("search tasks", "error", err)
+		http.Error(w, "", http.StatusInternalServerError)
+		return
+	}
+
+	vm := NewSearchResponse(r, req.Query, results)
+	vm.UI.Title = "Search"
+
+	h.Renderer.Render(w, "search.html", vm)
+}
@@ -20,8 +20,10 @@ func (h *GetUITaskAttachment) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	var task *shared.Task
+
 	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
-		_, err = txc.TaskRepository.GetByID(r.Context(), req.ID)
+		task, err = txc.TaskRepository.GetByID(r.Context(), req.ID)
 		return err
 	})
 
@@ -35,18 +37,32 @@ func (h *GetUITaskAttachment) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	data, err := h.TaskAttachmentsRepository.GetAttachment(r.Context(), req.ID, req.Name)
-	if err != nil {
-		h.Logger.Error("get task attachment", "error", err)
-		http.Error(w, "", http.StatusInternalServerError)
+	attachment := FindAttachment(task, req.Name)
+	if attachment == nil {
+		http.Error(w, "task attachment not found", http.StatusNotFound)
 		return
 	}
 
-	if len(data) == 0 {
-		http.Error(w, "task attachment not found", http.StatusNotFound)
+	content, err := h.TaskAttachmentsRepository.GetAttachment(r.Context(), task.ID, attachment)
+	if err != nil {
+		if err == shared.ErrNotFound {
+			http.Error(w, "task attachment not found", http.StatusNotFound)
+		} else {
+			h.Logger.Error("get task attachment", "error", err)
+			http.Error(w, "", http.StatusInternalServerError)
+		}
 		return
 	}
+	defer content.Close()
+
+	if content.ContentType != "" {
+		w.Header().Set("Content-Type", content.ContentType)
+	}
+	w.Header().Set("Content-Disposition", ContentDisposition(attachment.FileName))
+
+	http.ServeContent(w, r, attachment.FileName, content.ModTime, content)
 
-	w.Header().Set("Content-Type", http.DetectContentType(data))
-	w.Write(data)
+	if err := content.Err(); err != nil {
+		h.Logger.Error("verify task attachment", "error", err)
+	}
 }
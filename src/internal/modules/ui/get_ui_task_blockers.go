@@ -0,0 +1,48 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type GetUITaskBlockers struct {
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
+}
+
+func (h *GetUITaskBlockers) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseTaskRequest(r)
+	if err != nil {
+		h.Logger.Error("parse request", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var task *shared.Task
+	var candidates []*shared.Task
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		if task, err = txc.TaskRepository.GetByID(r.Context(), req.ID); err != nil {
+			return err
+		}
+
+		candidates, err = GetTaskBlockerCandidates(r.Context(), txc, task)
+		return err
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			http.Error(w, "task not found", http.StatusNotFound)
+		} else {
+			h.Logger.Error("get task blockers", "error", err)
+			http.Error(w, "", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	vm := NewTaskBlockersResponse(r, task, candidates)
+
+	h.Renderer.Render(w, "task_blockers_list.html", vm)
+}
@@ -0,0 +1,48 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type GetUITaskComments struct {
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
+}
+
+func (h *GetUITaskComments) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseTaskRequest(r)
+	if err != nil {
+		h.Logger.Error("parse request", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var task *shared.Task
+	var comments []*shared.TaskComment
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		if task, err = txc.TaskRepository.GetByID(r.Context(), req.ID); err != nil {
+			return err
+		}
+
+		comments, err = txc.TaskCommentRepository.GetByTaskID(r.Context(), req.ID)
+		return err
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			http.Error(w, "task not found", http.StatusNotFound)
+		} else {
+			h.Logger.Error("get task comments", "error", err)
+			http.Error(w, "", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	vm := NewTaskCommentsResponse(r, task, comments)
+
+	h.Renderer.Render(w, "task_comments_list.html", vm)
+}
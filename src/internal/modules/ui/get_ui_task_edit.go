@@ -21,9 +21,19 @@ func (h *GetUITaskEdit) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var task *shared.Task
+	var tags []*shared.Tag
+	var projects []*shared.Project
 
 	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
-		task, err = txc.TaskRepository.GetByID(r.Context(), req.ID)
+		if task, err = txc.TaskRepository.GetByID(r.Context(), req.ID); err != nil {
+			return err
+		}
+
+		if tags, err = txc.TaskRepository.GetTags(r.Context()); err != nil {
+			return err
+		}
+
+		projects, err = txc.ProjectRepository.GetAll(r.Context())
 		return err
 	})
 
@@ -38,6 +48,8 @@ func (h *GetUITaskEdit) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	vm := NewTaskResponse(r, task)
+	vm.Tags = tags
+	vm.Projects = projects
 
 	h.Renderer.Render(w, "active_tasks_table_row_edit.html", vm)
 }
@@ -0,0 +1,47 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type GetUITaskEvents struct {
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
+}
+
+func (h *GetUITaskEvents) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseTaskRequest(r)
+	if err != nil {
+		h.Logger.Error("parse request", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var events []*shared.TaskEvent
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		if _, err := txc.TaskRepository.GetByID(r.Context(), req.ID); err != nil {
+			return err
+		}
+
+		events, err = txc.TaskEventRepository.GetByTaskID(r.Context(), req.ID)
+		return err
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			http.Error(w, "task not found", http.StatusNotFound)
+		} else {
+			h.Logger.Error("get task events", "error", err)
+			http.Error(w, "", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	vm := NewTaskEventsResponse(r, events)
+
+	h.Renderer.Render(w, "task_events.html", vm)
+}
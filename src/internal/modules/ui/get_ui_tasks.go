@@ -13,11 +13,29 @@ type GetUITasks struct {
 }
 
 func (h *GetUITasks) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	var tasks []*shared.Task
-	var err error
+	sort := GetActiveSort(r)
+
+	if r.FormValue("sort") != "" {
+		req, err := ParseSortRequest(r, shared.SupportedActiveTaskSorts)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		sort = req.Sort
+		SetSortCookie(w, CookieNameActiveSort, sort)
+	}
+
+	cursor, err := ParseTaskCursor(r.FormValue("cursor"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var page *shared.TaskPage
 
 	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
-		tasks, err = txc.TaskRepository.GetActive(r.Context(), 0, 50)
+		page, err = txc.TaskRepository.GetActive(r.Context(), GetTaskFilter(r), sort, cursor, TasksPageSize)
 		return err
 	})
 
@@ -27,7 +45,12 @@ func (h *GetUITasks) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	vm := NewTasksResponse(r, tasks)
+	vm := NewTasksResponse(r, page)
+
+	if cursor != nil {
+		h.Renderer.Render(w, "active_tasks_table_rows.html", vm)
+		return
+	}
 
 	h.Renderer.Render(w, "active_tasks_table.html", vm)
 }
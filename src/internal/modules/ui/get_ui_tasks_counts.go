@@ -0,0 +1,46 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type GetUITasksCounts struct {
+	TxManager        shared.TxManager
+	AttachmentLimits *AttachmentLimits
+	Renderer         Renderer
+	Logger           *slog.Logger
+}
+
+func (h *GetUITasksCounts) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var counts *shared.TaskCounts
+	var usage int64
+	var err error
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		if counts, err = txc.TaskRepository.GetCounts(r.Context(), GetTaskFilter(r)); err != nil {
+			return err
+		}
+
+		user, err := shared.GetUserContext(r.Context())
+		if err != nil {
+			return err
+		}
+
+		usage, err = txc.TaskRepository.GetAttachmentUsage(r.Context(), user.ID)
+		return err
+	})
+
+	if err != nil {
+		h.Logger.Error("get task counts", "error", err)
+		http.Error(w, "", http.StatusInternalServerError)
+		return
+	}
+
+	vm := NewTaskCountsResponse(r, counts)
+	vm.AttachmentUsage = usage
+	vm.AttachmentQuota = h.AttachmentLimits.UserQuota
+
+	h.Renderer.Render(w, "navbar_counts.html", vm)
+}
@@ -14,22 +14,45 @@ type GetUITasksExport struct {
 }
 
 func (h *GetUITasksExport) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	filter := GetTaskFilter(r)
+
+	var ids []int
 	var name string
 	var tasks []*shared.Task
 	var err error
 
+	if values := r.Form["ids"]; 0 < len(values) {
+		if ids, err = ParseTaskIDs(values); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
 	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		if ids != nil {
+			name = "selected_tasks"
+			tasks, err = txc.TaskRepository.GetByIDs(r.Context(), ids)
+			return err
+		}
+
+		active := func(cursor *shared.TaskCursor) (*shared.TaskPage, error) {
+			return txc.TaskRepository.GetActive(r.Context(), filter, GetActiveSort(r), cursor, TasksExportPageSize)
+		}
+		completed := func(cursor *shared.TaskCursor) (*shared.TaskPage, error) {
+			return txc.TaskRepository.GetCompleted(r.Context(), filter, GetCompletedSort(r), cursor, TasksExportPageSize)
+		}
+
 		switch r.FormValue("filter") {
 		case "active":
 			name = "active_tasks"
-			tasks, err = txc.TaskRepository.GetActive(r.Context(), 0, 10_000)
+			tasks, err = GetAllTasks(active)
 		case "completed":
 			name = "completed_tasks"
-			tasks, err = txc.TaskRepository.GetCompleted(r.Context(), 0, 10_000)
+			tasks, err = GetAllTasks(completed)
 		default:
 			name = "all_tasks"
-			tasks1, err1 := txc.TaskRepository.GetActive(r.Context(), 0, 10_000)
-			tasks2, err2 := txc.TaskRepository.GetCompleted(r.Context(), 0, 10_000)
+			tasks1, err1 := GetAllTasks(active)
+			tasks2, err2 := GetAllTasks(completed)
 			tasks, err = append(tasks1, tasks2...), errors.Join(err1, err2)
 		}
 
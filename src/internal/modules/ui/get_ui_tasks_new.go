@@ -13,11 +13,21 @@ type GetUITasksNew struct {
 }
 
 func (h *GetUITasksNew) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	var tasks []*shared.Task
+	var page *shared.TaskPage
+	var tags []*shared.Tag
+	var projects []*shared.Project
 	var err error
 
 	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
-		tasks, err = txc.TaskRepository.GetActive(r.Context(), 0, 50)
+		if page, err = txc.TaskRepository.GetActive(r.Context(), GetTaskFilter(r), GetActiveSort(r), nil, TasksPageSize); err != nil {
+			return err
+		}
+
+		if tags, err = txc.TaskRepository.GetTags(r.Context()); err != nil {
+			return err
+		}
+
+		projects, err = txc.ProjectRepository.GetAll(r.Context())
 		return err
 	})
 
@@ -27,7 +37,9 @@ func (h *GetUITasksNew) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	vm := NewTasksResponse(r, tasks)
+	vm := NewTasksResponse(r, page)
+	vm.Tags = tags
+	vm.Projects = projects
 	vm.IsCreatingNew = true
 
 	h.Renderer.Render(w, "active_tasks_table.html", vm)
@@ -0,0 +1,34 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type GetUITrash struct {
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
+}
+
+func (h *GetUITrash) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var page *shared.TaskPage
+	var err error
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		page, err = txc.TaskRepository.GetDeleted(r.Context(), nil, TasksPageSize)
+		return err
+	})
+
+	if err != nil {
+		h.Logger.Error("get deleted tasks", "error", err)
+		http.Error(w, "", http.StatusInternalServerError)
+		return
+	}
+
+	vm := NewTasksResponse(r, page)
+	vm.UI.Title = "Trash"
+
+	h.Renderer.Render(w, "trash.html", vm)
+}
@@ -0,0 +1,43 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type GetUITrashTasks struct {
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
+}
+
+func (h *GetUITrashTasks) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cursor, err := ParseTaskCursor(r.FormValue("cursor"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var page *shared.TaskPage
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		page, err = txc.TaskRepository.GetDeleted(r.Context(), cursor, TasksPageSize)
+		return err
+	})
+
+	if err != nil {
+		h.Logger.Error("get deleted tasks", "error", err)
+		http.Error(w, "", http.StatusInternalServerError)
+		return
+	}
+
+	vm := NewTasksResponse(r, page)
+
+	if cursor != nil {
+		h.Renderer.Render(w, "trash_table_rows.html", vm)
+		return
+	}
+
+	h.Renderer.Render(w, "trash_table.html", vm)
+}
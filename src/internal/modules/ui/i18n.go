@@ -32,6 +32,11 @@ func SetLanguageCookie(w http.ResponseWriter, lang string) {
 	http.SetCookie(w, cookie)
 }
 
+// GetTranslations returns the translations for the language of the request.
+func GetTranslations(r *http.Request) map[string]string {
+	return Translations[GetLanguage(r)]
+}
+
 func GetLanguage(r *http.Request) string {
 	cookie, err := r.Cookie(CookieNameLanguage)
 	if err != nil {
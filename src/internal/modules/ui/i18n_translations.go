@@ -2,59 +2,321 @@ package ui
 
 var Translations = map[string]map[string]string{
 	"en": {
-		"active_tasks":                    "Active",
-		"attachments":                     "Attachments",
-		"cancel":                          "Cancel",
-		"complete":                        "Complete",
-		"completed_tasks":                 "Completed",
-		"completed":                       "Completed",
-		"confirm_task_completion_message": "Are you sure you want to complete the selected task?",
-		"confirm_task_completion_title":   "Confirm Task Completion",
-		"confirm_task_deletion_message":   "Are you sure you want to delete the selected task? This action cannot be undone.",
-		"confirm_task_deletion_title":     "Confirm Task Deletion",
-		"create":                          "Create",
-		"created":                         "Created",
-		"dark_theme":                      "Dark Theme",
-		"delete":                          "Delete",
-		"edit":                            "Edit",
-		"expiration":                      "Expiration",
-		"export":                          "Export",
-		"light_theme":                     "Light Theme",
-		"new_task":                        "New Task",
-		"no_completed_tasks":              "No completed tasks",
-		"no_tasks":                        "No tasks",
-		"refresh":                         "Refresh",
-		"save":                            "Save",
-		"sign_out":                        "Sign out",
-		"task":                            "Task",
-		"tasks":                           "Tasks",
+		"active":                           "Active",
+		"active_tasks":                     "Active",
+		"add":                              "Add",
+		"add_comment":                      "Comment",
+		"add_tag":                          "Add tag",
+		"all_assignees":                    "All assignees",
+		"all_projects":                     "All tasks",
+		"all_tags":                         "All tags",
+		"api_token_created_message":        "Copy the token now. It will not be shown again.",
+		"api_tokens":                       "API Tokens",
+		"assigned_to_me":                   "Assigned to me",
+		"assignee":                         "Assignee",
+		"assignee_placeholder":             "Assignee: user ID or email",
+		"attachment_file_too_large":        "%s is larger than the maximum file size of %s.",
+		"attachment_quota_exceeded":        "The attachments would exceed the storage quota of %s.",
+		"attachment_request_too_large":     "The upload exceeds the maximum size of %s.",
+		"attachment_too_many_files":        "A task can have at most %d attachments.",
+		"attachment_type_not_allowed":      "The file type of %s is not allowed.",
+		"attachment_usage":                 "Attachment storage used",
+		"attachments":                      "Attachments",
+		"auto_complete":                    "Complete when all items are done",
+		"blocked":                          "Blocked",
+		"blocked_by":                       "Blocked by",
+		"cancel":                           "Cancel",
+		"checklist":                        "Checklist",
+		"comment":                          "Comment",
+		"comment_placeholder":              "Write a comment, Markdown is supported",
+		"comments":                         "Comments",
+		"complete":                         "Complete",
+		"completed_tasks":                  "Completed",
+		"completed":                        "Completed",
+		"confirm_bulk_deletion_message":    "Are you sure you want to delete the selected tasks? Deleted tasks can be restored from the trash.",
+		"confirm_empty_trash_message":      "Are you sure you want to permanently delete all tasks in the trash? This action cannot be undone.",
+		"confirm_empty_trash_title":        "Confirm Emptying Trash",
+		"confirm_project_deletion_message": "Are you sure you want to delete the selected project? Its tasks are kept without a project.",
+		"confirm_project_deletion_title":   "Confirm Project Deletion",
+		"confirm_task_completion_message":  "Are you sure you want to complete the selected task?",
+		"confirm_task_completion_title":    "Confirm Task Completion",
+		"confirm_task_deletion_message":    "Are you sure you want to delete the selected task? Deleted tasks can be restored from the trash.",
+		"confirm_task_deletion_title":      "Confirm Task Deletion",
+		"confirm_token_revocation_message": "Are you sure you want to revoke the selected token? This action cannot be undone.",
+		"confirm_token_revocation_title":   "Confirm Token Revocation",
+		"create":                           "Create",
+		"created":                          "Created",
+		"dark_theme":                       "Dark Theme",
+		"days":                             "days",
+		"default_expiration":               "Default expiration",
+		"delete":                           "Delete",
+		"deleted":                          "Deleted",
+		"dependencies":                     "Dependencies",
+		"description":                      "Description",
+		"description_placeholder":          "Description (Markdown)",
+		"edit":                             "Edit",
+		"edit_latest":                      "Edit latest version",
+		"edited":                           "edited",
+		"empty_trash":                      "Empty Trash",
+		"event_assigned":                   "assigned the task",
+		"event_attachment_added":           "added an attachment",
+		"event_attachment_removed":         "removed an attachment",
+		"event_blocked":                    "added a blocker",
+		"event_blocker_removed":            "removed a blocker",
+		"event_completed":                  "completed the task",
+		"event_created":                    "created the task",
+		"event_deleted":                    "deleted the task",
+		"event_moved":                      "moved the task",
+		"event_notified":                   "sent a notification",
+		"event_renamed":                    "renamed the task",
+		"event_reopened":                   "reopened the task",
+		"event_rescheduled":                "changed the expiration",
+		"event_restored":                   "restored the task",
+		"event_shared":                     "shared the task",
+		"event_unassigned":                 "removed the assignee",
+		"event_unblocked":                  "unblocked the task, last blocker",
+		"event_unshared":                   "stopped sharing the task",
+		"expiration":                       "Expiration",
+		"export":                           "Export",
+		"export_selected":                  "Export selected",
+		"history":                          "History",
+		"last_used":                        "Last Used",
+		"light_theme":                      "Light Theme",
+		"load_more":                        "Load more",
+		"manage_projects":                  "Manage projects",
+		"member_placeholder":               "User ID or email",
+		"move_down":                        "Move down",
+		"move_to_project":                  "Move",
+		"move_up":                          "Move up",
+		"name":                             "Name",
+		"never":                            "Never",
+		"new_api_token":                    "New API Token",
+		"new_item":                         "New item",
+		"new_project":                      "New Project",
+		"new_task":                         "New Task",
+		"no_api_tokens":                    "No API tokens",
+		"no_blockers":                      "No blockers",
+		"no_comments":                      "No comments",
+		"no_completed_tasks":               "No completed tasks",
+		"no_deleted_tasks":                 "Trash is empty",
+		"no_history":                       "No history",
+		"no_project":                       "No project",
+		"no_projects":                      "No projects",
+		"no_search_results":                "No matching tasks",
+		"no_tasks":                         "No tasks",
+		"notification_expired":             "expired",
+		"notification_expiring":            "expiring",
+		"priority":                         "Priority",
+		"priority_high":                    "High",
+		"priority_low":                     "Low",
+		"priority_normal":                  "Normal",
+		"priority_urgent":                  "Urgent",
+		"project":                          "Project",
+		"recurrence":                       "Repeat",
+		"recurrence_biweekly":              "Every two weeks",
+		"recurrence_custom":                "Custom",
+		"recurrence_daily":                 "Daily",
+		"recurrence_monthly":               "Monthly",
+		"recurrence_none":                  "Does not repeat",
+		"recurrence_weekdays":              "Every weekday",
+		"recurrence_weekly":                "Weekly",
+		"recurrence_yearly":                "Yearly",
+		"refresh":                          "Refresh",
+		"reopen":                           "Reopen",
+		"reschedule_days":                  "Reschedule by days",
+		"restore":                          "Restore",
+		"revoke":                           "Revoke",
+		"role_editor":                      "Editor",
+		"role_viewer":                      "Viewer",
+		"save":                             "Save",
+		"scopes":                           "Scopes",
+		"search":                           "Search",
+		"search_placeholder":               "Search tasks and attachments",
+		"select":                           "Select",
+		"select_all":                       "Select all",
+		"select_blocker":                   "Select a blocking task",
+		"selected_tasks":                   "Selected",
+		"share":                            "Share",
+		"shared":                           "Shared",
+		"shared_with_you":                  "Shared with you",
+		"sharing":                          "Sharing",
+		"sign_out":                         "Sign out",
+		"sort":                             "Sort",
+		"sort_completed":                   "Completed",
+		"sort_created":                     "Created",
+		"sort_expiration":                  "Expiration",
+		"sort_name":                        "Name",
+		"sort_priority":                    "Priority",
+		"status":                           "Status",
+		"system":                           "System",
+		"tag":                              "Tag",
+		"tags_placeholder":                 "Tags, separated by commas",
+		"task":                             "Task",
+		"task_conflict_latest":             "Latest version",
+		"task_conflict_message":            "The task was changed in another window or by another client after you started editing. Your changes were not saved.",
+		"task_conflict_submitted":          "Your changes",
+		"task_conflict_title":              "Task was modified elsewhere",
+		"tasks":                            "Tasks",
+		"token":                            "Token",
+		"trash":                            "Trash",
 	},
 	"fi": {
-		"active_tasks":                    "Aktiiviset",
-		"attachments":                     "Liitteet",
-		"cancel":                          "Peruuta",
-		"complete":                        "Valmis",
-		"completed_tasks":                 "Valmiit",
-		"completed":                       "Valmis",
-		"confirm_task_completion_message": "Haluatko varmasti merkitä valitun tehtävän suoritetuksi?",
-		"confirm_task_completion_title":   "Vahvista tehtävän valmistuminen",
-		"confirm_task_deletion_message":   "Haluatko varmasti poistaa valitun tehtävän? Tätä toimintoa ei voi peruuttaa.",
-		"confirm_task_deletion_title":     "Vahvista tehtävän poistaminen",
-		"create":                          "Luo",
-		"created":                         "Luotu",
-		"dark_theme":                      "Tumma teema",
-		"delete":                          "Poista",
-		"edit":                            "Muokkaa",
-		"expiration":                      "Erääntyminen",
-		"export":                          "Vie",
-		"light_theme":                     "Vaalea teema",
-		"new_task":                        "Uusi tehtävä",
-		"no_completed_tasks":              "Ei valmiita tehtäviä",
-		"no_tasks":                        "Ei tehtäviä",
-		"refresh":                         "Päivitä",
-		"save":                            "Tallenna",
-		"sign_out":                        "Kirjaudu ulos",
-		"task":                            "Tehtävä",
-		"tasks":                           "Tehtävät",
+		"active":                           "Aktiivinen",
+		"active_tasks":                     "Aktiiviset",
+		"add":                              "Lisää",
+		"add_comment":                      "Kommentoi",
+		"add_tag":                          "Lisää tunniste",
+		"all_assignees":                    "Kaikki vastuuhenkilöt",
+		"all_projects":                     "Kaikki tehtävät",
+		"all_tags":                         "Kaikki tunnisteet",
+		"api_token_created_message":        "Kopioi tunniste nyt. Sitä ei näytetä uudelleen.",
+		"api_tokens":                       "API-tunnisteet",
+		"assigned_to_me":                   "Minulle osoitetut",
+		"assignee":                         "Vastuuhenkilö",
+		"assignee_placeholder":             "Vastuuhenkilö: käyttäjätunnus tai sähköposti",
+		"attachment_file_too_large":        "Tiedosto %s on suurempi kuin suurin sallittu koko %s.",
+		"attachment_quota_exceeded":        "Liitteet ylittäisivät tallennuskiintiön %s.",
+		"attachment_request_too_large":     "Lähetys ylittää enimmäiskoon %s.",
+		"attachment_too_many_files":        "Tehtävällä voi olla enintään %d liitettä.",
+		"attachment_type_not_allowed":      "Tiedoston %s tyyppi ei ole sallittu.",
+		"attachment_usage":                 "Liitteiden käyttämä tallennustila",
+		"attachments":                      "Liitteet",
+		"auto_complete":                    "Merkitse valmiiksi, kun kaikki kohdat on tehty",
+		"blocked":                          "Estetty",
+		"blocked_by":                       "Odottaa tehtävää",
+		"cancel":                           "Peruuta",
+		"checklist":                        "Tarkistuslista",
+		"comment":                          "Kommentti",
+		"comment_placeholder":              "Kirjoita kommentti, Markdown on tuettu",
+		"comments":                         "Kommentit",
+		"complete":                         "Valmis",
+		"completed_tasks":                  "Valmiit",
+		"completed":                        "Valmis",
+		"confirm_bulk_deletion_message":    "Haluatko varmasti poistaa valitut tehtävät? Poistetut tehtävät voi palauttaa roskakorista.",
+		"confirm_empty_trash_message":      "Haluatko varmasti poistaa pysyvästi kaikki roskakorin tehtävät? Tätä toimintoa ei voi peruuttaa.",
+		"confirm_empty_trash_title":        "Vahvista roskakorin tyhjentäminen",
+		"confirm_project_deletion_message": "Haluatko varmasti poistaa valitun projektin? Sen tehtävät säilyvät ilman projektia.",
+		"confirm_project_deletion_title":   "Vahvista projektin poistaminen",
+		"confirm_task_completion_message":  "Haluatko varmasti merkitä valitun tehtävän suoritetuksi?",
+		"confirm_task_completion_title":    "Vahvista tehtävän valmistuminen",
+		"confirm_task_deletion_message":    "Haluatko varmasti poistaa valitun tehtävän? Poistetut tehtävät voi palauttaa roskakorista.",
+		"confirm_task_deletion_title":      "Vahvista tehtävän poistaminen",
+		"confirm_token_revocation_message": "Haluatko varmasti mitätöidä valitun tunnisteen? Tätä toimintoa ei voi peruuttaa.",
+		"confirm_token_revocation_title":   "Vahvista tunnisteen mitätöinti",
+		"create":                           "Luo",
+		"created":                          "Luotu",
+		"dark_theme":                       "Tumma teema",
+		"days":                             "päivää",
+		"default_expiration":               "Oletuserääntyminen",
+		"delete":                           "Poista",
+		"deleted":                          "Poistettu",
+		"dependencies":                     "Riippuvuudet",
+		"description":                      "Kuvaus",
+		"description_placeholder":          "Kuvaus (Markdown)",
+		"edit":                             "Muokkaa",
+		"edit_latest":                      "Muokkaa uusinta versiota",
+		"edited":                           "muokattu",
+		"empty_trash":                      "Tyhjennä roskakori",
+		"event_assigned":                   "osoitti tehtävän",
+		"event_attachment_added":           "lisäsi liitteen",
+		"event_attachment_removed":         "poisti liitteen",
+		"event_blocked":                    "lisäsi estävän tehtävän",
+		"event_blocker_removed":            "poisti estävän tehtävän",
+		"event_completed":                  "merkitsi valmiiksi",
+		"event_created":                    "loi tehtävän",
+		"event_deleted":                    "poisti tehtävän",
+		"event_moved":                      "siirsi tehtävän",
+		"event_notified":                   "lähetti ilmoituksen",
+		"event_renamed":                    "nimesi tehtävän uudelleen",
+		"event_reopened":                   "avasi uudelleen",
+		"event_rescheduled":                "muutti erääntymisaikaa",
+		"event_restored":                   "palautti tehtävän",
+		"event_shared":                     "jakoi tehtävän",
+		"event_unassigned":                 "poisti vastuuhenkilön",
+		"event_unblocked":                  "vapautti tehtävän, viimeinen estävä tehtävä",
+		"event_unshared":                   "lopetti tehtävän jakamisen",
+		"expiration":                       "Erääntyminen",
+		"export":                           "Vie",
+		"export_selected":                  "Vie valitut",
+		"history":                          "Historia",
+		"last_used":                        "Viimeksi käytetty",
+		"light_theme":                      "Vaalea teema",
+		"load_more":                        "Lataa lisää",
+		"manage_projects":                  "Hallitse projekteja",
+		"member_placeholder":               "Käyttäjätunnus tai sähköposti",
+		"move_down":                        "Siirrä alas",
+		"move_to_project":                  "Siirrä",
+		"move_up":                          "Siirrä ylös",
+		"name":                             "Nimi",
+		"never":                            "Ei koskaan",
+		"new_api_token":                    "Uusi API-tunniste",
+		"new_item":                         "Uusi kohta",
+		"new_project":                      "Uusi projekti",
+		"new_task":                         "Uusi tehtävä",
+		"no_api_tokens":                    "Ei API-tunnisteita",
+		"no_blockers":                      "Ei estäviä tehtäviä",
+		"no_comments":                      "Ei kommentteja",
+		"no_completed_tasks":               "Ei valmiita tehtäviä",
+		"no_deleted_tasks":                 "Roskakori on tyhjä",
+		"no_history":                       "Ei historiaa",
+		"no_project":                       "Ei projektia",
+		"no_projects":                      "Ei projekteja",
+		"no_search_results":                "Ei hakutuloksia",
+		"no_tasks":                         "Ei tehtäviä",
+		"notification_expired":             "erääntynyt",
+		"notification_expiring":            "erääntymässä",
+		"priority":                         "Prioriteetti",
+		"priority_high":                    "Korkea",
+		"priority_low":                     "Matala",
+		"priority_normal":                  "Normaali",
+		"priority_urgent":                  "Kiireellinen",
+		"project":                          "Projekti",
+		"recurrence":                       "Toisto",
+		"recurrence_biweekly":              "Joka toinen viikko",
+		"recurrence_custom":                "Mukautettu",
+		"recurrence_daily":                 "Päivittäin",
+		"recurrence_monthly":               "Kuukausittain",
+		"recurrence_none":                  "Ei toistoa",
+		"recurrence_weekdays":              "Arkipäivisin",
+		"recurrence_weekly":                "Viikoittain",
+		"recurrence_yearly":                "Vuosittain",
+		"refresh":                          "Päivitä",
+		"reopen":                           "Avaa uudelleen",
+		"reschedule_days":                  "Siirrä päivillä",
+		"restore":                          "Palauta",
+		"revoke":                           "Mitätöi",
+		"role_editor":                      "Muokkaaja",
+		"role_viewer":                      "Katselija",
+		"save":                             "Tallenna",
+		"scopes":                           "Oikeudet",
+		"search":                           "Haku",
+		"search_placeholder":               "Hae tehtäviä ja liitteitä",
+		"select":                           "Valitse",
+		"select_all":                       "Valitse kaikki",
+		"select_blocker":                   "Valitse estävä tehtävä",
+		"selected_tasks":                   "Valitut",
+		"share":                            "Jaa",
+		"shared":                           "Jaettu",
+		"shared_with_you":                  "Jaettu kanssasi",
+		"sharing":                          "Jakaminen",
+		"sign_out":                         "Kirjaudu ulos",
+		"sort":                             "Järjestys",
+		"sort_completed":                   "Valmistunut",
+		"sort_created":                     "Luotu",
+		"sort_expiration":                  "Erääntyminen",
+		"sort_name":                        "Nimi",
+		"sort_priority":                    "Prioriteetti",
+		"status":                           "Tila",
+		"system":                           "Järjestelmä",
+		"tag":                              "Tunniste",
+		"tags_placeholder":                 "Tunnisteet pilkuilla eroteltuna",
+		"task":                             "Tehtävä",
+		"task_conflict_latest":             "Uusin versio",
+		"task_conflict_message":            "Tehtävää muutettiin toisessa ikkunassa tai toisella sovelluksella muokkauksen aloittamisen jälkeen. Muutoksiasi ei tallennettu.",
+		"task_conflict_submitted":          "Sinun muutoksesi",
+		"task_conflict_title":              "Tehtävää on muokattu muualla",
+		"tasks":                            "Tehtävät",
+		"token":                            "Tunniste",
+		"trash":                            "Roskakori",
 	},
 }
@@ -1,9 +1,11 @@
 package ui
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"strings"
 	"tasks-app/internal/shared"
 
 	"github.com/nats-io/jwt/v2"
@@ -45,6 +47,47 @@ func UserContextMiddleware(auth *Auth) func(next http.Handler) http.Handler {
 	}
 }
 
+func BearerAuthMiddleware(resolver TokenResolver, logger *slog.Logger) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !found || token == "" {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				WriteProblem(w, http.StatusUnauthorized, "bearer token required")
+				return
+			}
+
+			user, err := resolver.ResolveToken(r.Context(), token)
+			if err != nil {
+				if !errors.Is(err, ErrInvalidToken) {
+					logger.Error("resolve token", "error", err)
+				}
+				w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+				WriteProblem(w, http.StatusUnauthorized, "invalid bearer token")
+				return
+			}
+
+			r = r.WithContext(shared.WithUserContext(r.Context(), user))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func RequireScopeMiddleware(scope string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			user, err := shared.GetUserContext(r.Context())
+			if err != nil || !user.HasScope(scope) {
+				w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer error="insufficient_scope", scope="%s"`, scope))
+				WriteProblem(w, http.StatusForbidden, fmt.Sprintf("scope required: %s", scope))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
 func NATSJWTMiddleware(auth *Auth) func(next http.Handler) http.Handler {
 	natsJWT := &shared.NATSJWT{Config: auth.Config}
 
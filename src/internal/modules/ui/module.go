@@ -17,6 +17,7 @@ type Module struct {
 	Logger                    *slog.Logger
 	NATSConn                  *nats.Conn
 	Auth                      *Auth
+	TokenResolver             TokenResolver
 	Renderer                  Renderer
 	TxManager                 shared.TxManager
 	TaskAttachmentsRepository shared.TaskAttachmentsRepository
@@ -34,11 +35,20 @@ func (m *Module) Run(ctx context.Context) error {
 		return fmt.Errorf("init renderer: %w", err)
 	}
 
+	if err := m.initTokenResolver(); err != nil {
+		return fmt.Errorf("init token resolver: %w", err)
+	}
+
 	errorMW := ErrorRecoveryMiddleware(m.Logger)
 	copMW := http.NewCrossOriginProtection()
 	authnMW := m.Auth.Middleware.RequireAuthentication()
 	userMW := UserContextMiddleware(m.Auth)
 	natsJWTMW := NATSJWTMiddleware(m.Auth)
+	bearerMW := BearerAuthMiddleware(m.TokenResolver, m.Logger)
+	readMW := RequireScopeMiddleware(shared.ScopeTasksRead)
+	writeMW := RequireScopeMiddleware(shared.ScopeTasksWrite)
+
+	attachmentLimits := NewAttachmentLimits(&m.Config.UI)
 
 	mux := http.NewServeMux()
 
@@ -60,17 +70,64 @@ func (m *Module) Run(ctx context.Context) error {
 	HandleWithMiddleware(mux, "POST /ui/theme", &PostUITheme{m.Config, m.Logger}, authnMW, userMW)
 	HandleWithMiddleware(mux, "POST /ui/timezone", &PostUITimezone{m.Config, m.Logger}, authnMW, userMW)
 	HandleWithMiddleware(mux, "GET /ui/tasks", &GetUITasks{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "GET /ui/tasks/counts", &GetUITasksCounts{m.TxManager, attachmentLimits, m.Renderer, m.Logger}, authnMW, userMW)
 	HandleWithMiddleware(mux, "GET /ui/tasks/export", &GetUITasksExport{m.TxManager, m.FileExporter, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "POST /ui/tasks/bulk/{action}", &PostUITasksBulk{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
 	HandleWithMiddleware(mux, "GET /ui/tasks/new", &GetUITasksNew{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
 	HandleWithMiddleware(mux, "GET /ui/tasks/{id}", &GetUITask{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
 	HandleWithMiddleware(mux, "GET /ui/tasks/{id}/edit", &GetUITaskEdit{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
 	HandleWithMiddleware(mux, "GET /ui/tasks/{id}/attachments/{name}", &GetUITaskAttachment{m.TxManager, m.TaskAttachmentsRepository, m.Logger}, authnMW, userMW)
-	HandleWithMiddleware(mux, "POST /ui/tasks", &PostUITasks{m.TxManager, m.TaskAttachmentsRepository, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "POST /ui/tasks", &PostUITasks{m.TxManager, m.TaskAttachmentsRepository, attachmentLimits, m.Renderer, m.Logger}, authnMW, userMW)
 	HandleWithMiddleware(mux, "POST /ui/tasks/{id}/complete", &PostUITaskComplete{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
-	HandleWithMiddleware(mux, "PUT /ui/tasks/{id}", &PutUITask{m.TxManager, m.TaskAttachmentsRepository, m.Renderer, m.Logger}, authnMW, userMW)
-	HandleWithMiddleware(mux, "DELETE /ui/tasks/{id}", &DeleteUITask{m.TxManager, m.TaskAttachmentsRepository, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "PUT /ui/tasks/{id}", &PutUITask{m.TxManager, m.TaskAttachmentsRepository, attachmentLimits, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "DELETE /ui/tasks/{id}", &DeleteUITask{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "POST /ui/tasks/{id}/reopen", &PostUITaskReopen{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "POST /ui/tasks/{id}/restore", &PostUITaskRestore{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "GET /ui/tasks/{id}/events", &GetUITaskEvents{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "GET /ui/tasks/{id}/items", &GetUITaskItems{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "POST /ui/tasks/{id}/items", &PostUITaskItems{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "PUT /ui/tasks/{id}/items/{item_id}", &PutUITaskItem{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "DELETE /ui/tasks/{id}/items/{item_id}", &DeleteUITaskItem{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "POST /ui/tasks/{id}/items/{item_id}/move", &PostUITaskItemMove{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "GET /ui/tasks/{id}/blockers", &GetUITaskBlockers{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "POST /ui/tasks/{id}/blockers", &PostUITaskBlockers{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "DELETE /ui/tasks/{id}/blockers/{blocker_id}", &DeleteUITaskBlocker{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "GET /ui/tasks/{id}/comments", &GetUITaskComments{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "POST /ui/tasks/{id}/comments", &PostUITaskComments{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "PUT /ui/tasks/{id}/comments/{comment_id}", &PutUITaskComment{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "DELETE /ui/tasks/{id}/comments/{comment_id}", &DeleteUITaskComment{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "POST /ui/tasks/{id}/members", &PostUITaskMembers{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "DELETE /ui/tasks/{id}/members/{member_id}", &DeleteUITaskMember{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
 	HandleWithMiddleware(mux, "GET /ui/completed", &GetUICompleted{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW, natsJWTMW)
 	HandleWithMiddleware(mux, "GET /ui/completed/tasks", &GetUICompletedTasks{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "GET /ui/trash", &GetUITrash{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW, natsJWTMW)
+	HandleWithMiddleware(mux, "GET /ui/trash/tasks", &GetUITrashTasks{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "DELETE /ui/trash", &DeleteUITrash{m.TxManager, m.TaskAttachmentsRepository, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "GET /ui/search", &GetUISearch{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW, natsJWTMW)
+	HandleWithMiddleware(mux, "GET /ui/search/tasks", &GetUISearchTasks{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "GET /ui/tokens", &GetUIAPITokens{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW, natsJWTMW)
+	HandleWithMiddleware(mux, "POST /ui/tokens", &PostUIAPITokens{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "DELETE /ui/tokens/{id}", &DeleteUIAPIToken{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "POST /ui/project", &PostUIProject{m.Config, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "GET /ui/projects", &GetUIProjects{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW, natsJWTMW)
+	HandleWithMiddleware(mux, "GET /ui/projects/menu", &GetUIProjectsMenu{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "POST /ui/projects", &PostUIProjects{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "PUT /ui/projects/{id}", &PutUIProject{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "DELETE /ui/projects/{id}", &DeleteUIProject{m.TxManager, m.Renderer, m.Logger}, authnMW, userMW)
+	HandleWithMiddleware(mux, "GET /api/v1/tasks", &GetAPITasks{m.TxManager, m.Logger}, bearerMW, readMW)
+	HandleWithMiddleware(mux, "GET /api/v1/tasks/export", &GetAPITasksExport{m.TxManager, m.FileExporter, m.Logger}, bearerMW, readMW)
+	HandleWithMiddleware(mux, "GET /api/v1/tasks/search", &GetAPITasksSearch{m.TxManager, m.Logger}, bearerMW, readMW)
+	HandleWithMiddleware(mux, "GET /api/v1/projects", &GetAPIProjects{m.TxManager, m.Logger}, bearerMW, readMW)
+	HandleWithMiddleware(mux, "GET /api/v1/tasks/{id}", &GetAPITask{m.TxManager, m.Logger}, bearerMW, readMW)
+	HandleWithMiddleware(mux, "GET /api/v1/tasks/{id}/attachments/{name}", &GetAPITaskAttachment{m.TxManager, m.TaskAttachmentsRepository, m.Logger}, bearerMW, readMW)
+	HandleWithMiddleware(mux, "POST /api/v1/tasks", &PostAPITasks{m.TxManager, m.Logger}, bearerMW, writeMW)
+	HandleWithMiddleware(mux, "POST /api/v1/tasks/{id}/complete", &PostAPITaskComplete{m.TxManager, m.Logger}, bearerMW, writeMW)
+	HandleWithMiddleware(mux, "POST /api/v1/tasks/{id}/reopen", &PostAPITaskReopen{m.TxManager, m.Logger}, bearerMW, writeMW)
+	HandleWithMiddleware(mux, "POST /api/v1/tasks/{id}/restore", &PostAPITaskRestore{m.TxManager, m.Logger}, bearerMW, writeMW)
+	HandleWithMiddleware(mux, "POST /api/v1/tasks/{id}/attachments", &PostAPITaskAttachments{m.TxManager, m.TaskAttachmentsRepository, attachmentLimits, m.Logger}, bearerMW, writeMW)
+	HandleWithMiddleware(mux, "PUT /api/v1/tasks/{id}", &PutAPITask{m.TxManager, m.Logger}, bearerMW, writeMW)
+	HandleWithMiddleware(mux, "DELETE /api/v1/tasks/{id}", &DeleteAPITask{m.TxManager, m.Logger}, bearerMW, writeMW)
+	HandleWithMiddleware(mux, "DELETE /api/v1/tasks/{id}/attachments/{name}", &DeleteAPITaskAttachment{m.TxManager, m.TaskAttachmentsRepository, m.Logger}, bearerMW, writeMW)
 
 	server := &http.Server{
 		ReadTimeout:  60 * time.Second,
@@ -117,3 +174,11 @@ func (m *Module) initRenderer() error {
 	m.Renderer = renderer
 	return nil
 }
+
+func (m *Module) initTokenResolver() error {
+	m.TokenResolver = &APITokenResolver{
+		TxManager: m.TxManager,
+		Next:      NewZitadelTokenResolver(m.Config),
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type PostAPITaskAttachments struct {
+	TxManager                 shared.TxManager
+	TaskAttachmentsRepository shared.TaskAttachmentsRepository
+	AttachmentLimits          *AttachmentLimits
+	Logger                    *slog.Logger
+}
+
+func (h *PostAPITaskAttachments) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := h.AttachmentLimits.LimitRequest(w, r); err != nil {
+		WriteProblem(w, http.StatusRequestEntityTooLarge, err.Error())
+		return
+	}
+
+	req, err := ParseAPITaskAttachmentsRequest(r)
+	if limitErr := h.AttachmentLimits.RequestTooLarge(err); limitErr != nil {
+		WriteProblem(w, http.StatusRequestEntityTooLarge, limitErr.Error())
+		return
+	}
+	if err != nil {
+		WriteProblem(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var task *shared.Task
+	var attachments *AttachmentsUpdate
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		if task, err = txc.TaskRepository.GetByID(r.Context(), req.ID); err != nil {
+			return err
+		}
+
+		if err := shared.AuthorizeTask(r.Context(), task, shared.TaskRoleOwner, shared.TaskRoleEditor); err != nil {
+			return err
+		}
+
+		var names []string
+		for _, a := range task.Attachments {
+			names = append(names, a.FileName)
+		}
+
+		if attachments, err = BuildAttachmentsUpdate(task.Attachments, names, req.Files); err != nil {
+			return err
+		}
+
+		if err := h.AttachmentLimits.Check(r.Context(), txc, task, attachments); err != nil {
+			return err
+		}
+
+		if err := txc.TaskRepository.UpdateAttachments(r.Context(), task.ID, attachments.Inserted, attachments.Updated, attachments.Deleted); err != nil {
+			return err
+		}
+
+		if err := txc.TaskEventRepository.Create(r.Context(), shared.NewTaskAttachmentEvents(task.ID, attachments.Inserted, attachments.Deleted)...); err != nil {
+			return err
+		}
+
+		if task, err = txc.TaskRepository.GetByID(r.Context(), req.ID); err != nil {
+			return err
+		}
+
+		return h.TaskAttachmentsRepository.SaveAttachments(r.Context(), task.ID, attachments.Uploads)
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			WriteProblem(w, http.StatusNotFound, "task not found")
+		} else if err == shared.ErrForbidden {
+			WriteProblem(w, http.StatusForbidden, "task cannot be modified")
+		} else if _, ok := err.(*AttachmentLimitError); ok {
+			WriteProblem(w, http.StatusUnprocessableEntity, err.Error())
+		} else {
+			h.Logger.Error("save task attachments", "error", err)
+			WriteProblem(w, http.StatusInternalServerError, "")
+		}
+		return
+	}
+
+	if err := h.TaskAttachmentsRepository.DeleteAttachments(r.Context(), task.ID, attachments.Obsolete()); err != nil {
+		h.Logger.Error("delete task attachments", "error", err)
+	}
+
+	WriteTaskJSON(w, http.StatusOK, task)
+}
@@ -0,0 +1,74 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type PostAPITaskComplete struct {
+	TxManager shared.TxManager
+	Logger    *slog.Logger
+}
+
+func (h *PostAPITaskComplete) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseTaskRequest(r)
+	if err != nil {
+		WriteProblem(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var task *shared.Task
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		if task, err = txc.TaskRepository.GetByID(r.Context(), req.ID); err != nil {
+			return err
+		}
+
+		if err := shared.AuthorizeTask(r.Context(), task, shared.TaskRoleOwner, shared.TaskRoleEditor); err != nil {
+			return err
+		}
+
+		if task.CompletedAt != nil {
+			return nil
+		}
+
+		if task.IsBlocked() {
+			return shared.ErrBlocked
+		}
+
+		task.SetCompleted()
+
+		if _, err := shared.CreateNextOccurrence(r.Context(), txc, task); err != nil {
+			return err
+		}
+
+		if err := txc.TaskRepository.Update(r.Context(), task); err != nil {
+			return err
+		}
+
+		if err := shared.UnblockDependents(r.Context(), txc, task); err != nil {
+			return err
+		}
+
+		return txc.TaskEventRepository.Create(r.Context(), shared.NewTaskEvent(task.ID, shared.TaskEventCompleted, shared.TaskEventData{}))
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			WriteProblem(w, http.StatusNotFound, "task not found")
+		} else if err == shared.ErrForbidden {
+			WriteProblem(w, http.StatusForbidden, "task cannot be modified")
+		} else if err == shared.ErrConflict {
+			WriteProblem(w, http.StatusConflict, "task was modified concurrently")
+		} else if err == shared.ErrBlocked {
+			WriteProblem(w, http.StatusUnprocessableEntity, "task is blocked by open tasks")
+		} else {
+			h.Logger.Error("complete task", "error", err)
+			WriteProblem(w, http.StatusInternalServerError, "")
+		}
+		return
+	}
+
+	WriteTaskJSON(w, http.StatusOK, task)
+}
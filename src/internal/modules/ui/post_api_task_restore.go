@@ -0,0 +1,60 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type PostAPITaskRestore struct {
+	TxManager shared.TxManager
+	Logger    *slog.Logger
+}
+
+func (h *PostAPITaskRestore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseTaskRequest(r)
+	if err != nil {
+		WriteProblem(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var task *shared.Task
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		if task, err = txc.TaskRepository.GetByID(r.Context(), req.ID); err != nil {
+			return err
+		}
+
+		if err := shared.AuthorizeTask(r.Context(), task, shared.TaskRoleOwner); err != nil {
+			return err
+		}
+
+		if !task.IsDeleted() {
+			return nil
+		}
+
+		task.Restore()
+
+		if err := txc.TaskRepository.Update(r.Context(), task); err != nil {
+			return err
+		}
+
+		return txc.TaskEventRepository.Create(r.Context(), shared.NewTaskEvent(task.ID, shared.TaskEventRestored, shared.TaskEventData{}))
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			WriteProblem(w, http.StatusNotFound, "task not found")
+		} else if err == shared.ErrForbidden {
+			WriteProblem(w, http.StatusForbidden, "task cannot be modified")
+		} else if err == shared.ErrConflict {
+			WriteProblem(w, http.StatusConflict, "task was modified concurrently")
+		} else {
+			h.Logger.Error("restore task", "error", err)
+			WriteProblem(w, http.StatusInternalServerError, "")
+		}
+		return
+	}
+
+	WriteTaskJSON(w, http.StatusOK, task)
+}
@@ -0,0 +1,71 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type PostAPITasks struct {
+	TxManager shared.TxManager
+	Logger    *slog.Logger
+}
+
+func (h *PostAPITasks) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseAPINewTaskRequest(r)
+	if err != nil {
+		WriteProblem(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	task := shared.NewTask(req.Name, req.Description, req.Priority, req.ExpiresAt)
+	task.AutoComplete = req.AutoComplete
+
+	task.Assign(req.Assignee.UserID, req.Assignee.Email)
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		project, err := GetTaskProject(r.Context(), txc.ProjectRepository, req.ProjectID)
+		if err != nil {
+			return err
+		}
+
+		if project != nil {
+			task.SetProject(project)
+
+			if task.ExpiresAt == nil {
+				task.ExpiresAt = project.DefaultExpiresAt(task.CreatedAt)
+			}
+		}
+
+		task.SetRecurrence(req.Recurrence, req.Timezone)
+
+		if err := txc.TaskRepository.Create(r.Context(), task); err != nil {
+			return err
+		}
+
+		if err := txc.TaskRepository.UpdateTags(r.Context(), task.ID, req.Tags); err != nil {
+			return err
+		}
+
+		if err := txc.TaskEventRepository.Create(r.Context(), shared.NewTaskEvent(task.ID, shared.TaskEventCreated, shared.TaskEventData{})); err != nil {
+			return err
+		}
+
+		task, err = txc.TaskRepository.GetByID(r.Context(), task.ID)
+		return err
+	})
+
+	if err != nil {
+		if err == ErrProjectNotFound {
+			WriteProblem(w, http.StatusBadRequest, "project_id: not found")
+		} else {
+			h.Logger.Error("create task", "error", err)
+			WriteProblem(w, http.StatusInternalServerError, "")
+		}
+		return
+	}
+
+	w.Header().Set("Location", TaskAPIURL(task.ID))
+
+	WriteTaskJSON(w, http.StatusCreated, task)
+}
@@ -0,0 +1,46 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type PostUIAPITokens struct {
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
+}
+
+func (h *PostUIAPITokens) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseNewAPITokenRequest(r)
+	if err != nil {
+		h.Logger.Error("parse request", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	token, value := shared.NewAPIToken(req.Name, req.Scopes, req.ExpiresAt)
+
+	var tokens []*shared.APIToken
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		if err := txc.APITokenRepository.Create(r.Context(), token); err != nil {
+			return err
+		}
+
+		tokens, err = txc.APITokenRepository.GetAll(r.Context())
+		return err
+	})
+
+	if err != nil {
+		h.Logger.Error("create api token", "error", err)
+		http.Error(w, "", http.StatusInternalServerError)
+		return
+	}
+
+	vm := NewAPITokensResponse(r, tokens)
+	vm.NewToken = value
+
+	h.Renderer.Render(w, "api_tokens_table.html", vm)
+}
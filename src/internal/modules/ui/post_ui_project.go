@@ -0,0 +1,32 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type PostUIProject struct {
+	Config *shared.Config
+	Logger *slog.Logger
+}
+
+func (h *PostUIProject) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	redirectURL, err := GetRedirectURL(r, h.Config.UI.TrustedHosts)
+	if err != nil {
+		h.Logger.Error("get redirect url", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	req, err := ParseSetProjectRequest(r)
+	if err != nil {
+		h.Logger.Error("parse request", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	SetProjectCookie(w, req.ProjectID)
+
+	http.Redirect(w, r, redirectURL, http.StatusFound)
+}
@@ -0,0 +1,49 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type PostUIProjects struct {
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
+}
+
+func (h *PostUIProjects) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseNewProjectRequest(r)
+	if err != nil {
+		h.Logger.Error("parse request", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	project := shared.NewProject(req.Name, req.ExpirationDays)
+
+	var projects []*shared.Project
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		if err := txc.ProjectRepository.Create(r.Context(), project); err != nil {
+			return err
+		}
+
+		projects, err = txc.ProjectRepository.GetAll(r.Context())
+		return err
+	})
+
+	if err != nil {
+		if err == shared.ErrDuplicate {
+			http.Error(w, "name: a project with this name already exists", http.StatusConflict)
+		} else {
+			h.Logger.Error("create project", "error", err)
+			http.Error(w, "", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	vm := NewProjectsResponse(r, projects)
+
+	h.Renderer.Render(w, "projects_table.html", vm)
+}
@@ -0,0 +1,85 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type PostUITaskBlockers struct {
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
+}
+
+func (h *PostUITaskBlockers) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseNewTaskBlockerRequest(r)
+	if err != nil {
+		h.Logger.Error("parse request", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var task *shared.Task
+	var candidates []*shared.Task
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		if task, err = txc.TaskRepository.GetByID(r.Context(), req.TaskID); err != nil {
+			return err
+		}
+
+		blocker, err := txc.TaskRepository.GetByID(r.Context(), req.BlockerID)
+		if err != nil {
+			return err
+		}
+
+		if blocker.IsDeleted() {
+			return shared.ErrNotFound
+		}
+
+		// Blockers link tasks of the same user.
+		if err := shared.AuthorizeTask(r.Context(), task, shared.TaskRoleOwner); err != nil {
+			return err
+		}
+
+		if err := shared.AuthorizeTask(r.Context(), blocker, shared.TaskRoleOwner); err != nil {
+			return err
+		}
+
+		created, err := txc.TaskBlockerRepository.Create(r.Context(), task.ID, blocker.ID)
+		if err != nil {
+			return err
+		}
+
+		if created {
+			if err := txc.TaskEventRepository.Create(r.Context(), shared.NewTaskEvent(task.ID, shared.TaskEventBlocked, shared.TaskEventData{Blocker: blocker.Name})); err != nil {
+				return err
+			}
+		}
+
+		if task, err = txc.TaskRepository.GetByID(r.Context(), req.TaskID); err != nil {
+			return err
+		}
+
+		candidates, err = GetTaskBlockerCandidates(r.Context(), txc, task)
+		return err
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			http.Error(w, "task not found", http.StatusNotFound)
+		} else if err == shared.ErrForbidden {
+			http.Error(w, "task cannot be modified", http.StatusForbidden)
+		} else if err == shared.ErrCycle {
+			http.Error(w, "blocker would create a cycle", http.StatusUnprocessableEntity)
+		} else {
+			h.Logger.Error("create task blocker", "error", err)
+			http.Error(w, "", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	vm := NewTaskBlockersResponse(r, task, candidates)
+
+	h.Renderer.Render(w, "task_blockers_list.html", vm)
+}
@@ -0,0 +1,66 @@
+package ui
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type PostUITaskComments struct {
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
+}
+
+func (h *PostUITaskComments) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseNewTaskCommentRequest(r)
+	if err != nil {
+		h.Logger.Error("parse request", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var task *shared.Task
+	var comments []*shared.TaskComment
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		if task, err = txc.TaskRepository.GetByID(r.Context(), req.TaskID); err != nil {
+			return err
+		}
+
+		comment := shared.NewTaskComment(req.TaskID, req.Body)
+
+		if err := txc.TaskCommentRepository.Create(r.Context(), comment); err != nil {
+			return err
+		}
+
+		for _, userID := range comment.Recipients(task) {
+			msg, err := shared.NewOutboxMessage(fmt.Sprintf("task.%s.%d.commented", userID, task.ID), shared.TaskCommentedMsg{Task: task, Comment: comment})
+			if err != nil {
+				return err
+			}
+
+			if err := txc.OutboxRepository.Create(r.Context(), msg); err != nil {
+				return err
+			}
+		}
+
+		comments, err = txc.TaskCommentRepository.GetByTaskID(r.Context(), req.TaskID)
+		return err
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			http.Error(w, "task not found", http.StatusNotFound)
+		} else {
+			h.Logger.Error("create task comment", "error", err)
+			http.Error(w, "", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	vm := NewTaskCommentsResponse(r, task, comments)
+
+	h.Renderer.Render(w, "task_comments_list.html", vm)
+}
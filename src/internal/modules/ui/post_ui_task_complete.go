@@ -26,14 +26,38 @@ func (h *PostUITaskComplete) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			return err
 		}
 
+		if err := shared.AuthorizeTask(r.Context(), task, shared.TaskRoleOwner, shared.TaskRoleEditor); err != nil {
+			return err
+		}
+
+		if task.IsBlocked() {
+			return shared.ErrBlocked
+		}
+
 		task.SetCompleted()
 
-		return txc.TaskRepository.Update(r.Context(), task)
+		if _, err := shared.CreateNextOccurrence(r.Context(), txc, task); err != nil {
+			return err
+		}
+
+		if err := txc.TaskRepository.Update(r.Context(), task); err != nil {
+			return err
+		}
+
+		if err := shared.UnblockDependents(r.Context(), txc, task); err != nil {
+			return err
+		}
+
+		return txc.TaskEventRepository.Create(r.Context(), shared.NewTaskEvent(task.ID, shared.TaskEventCompleted, shared.TaskEventData{}))
 	})
 
 	if err != nil {
 		if err == shared.ErrNotFound {
 			http.Error(w, "task not found", http.StatusNotFound)
+		} else if err == shared.ErrForbidden {
+			http.Error(w, "task cannot be modified", http.StatusForbidden)
+		} else if err == shared.ErrBlocked {
+			http.Error(w, "task is blocked by open tasks", http.StatusUnprocessableEntity)
 		} else {
 			h.Logger.Error("complete task", "error", err)
 			http.Error(w, "", http.StatusInternalServerError)
@@ -41,10 +65,10 @@ func (h *PostUITaskComplete) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var tasks []*shared.Task
+	var page *shared.TaskPage
 
 	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
-		tasks, err = txc.TaskRepository.GetActive(r.Context(), 0, 50)
+		page, err = txc.TaskRepository.GetActive(r.Context(), GetTaskFilter(r), GetActiveSort(r), nil, TasksPageSize)
 		return err
 	})
 
@@ -54,7 +78,7 @@ func (h *PostUITaskComplete) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	vm := NewTasksResponse(r, tasks)
+	vm := NewTasksResponse(r, page)
 
 	h.Renderer.Render(w, "active_tasks_table.html", vm)
 }
@@ -0,0 +1,84 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"slices"
+	"tasks-app/internal/shared"
+)
+
+type PostUITaskItemMove struct {
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
+}
+
+func (h *PostUITaskItemMove) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseMoveTaskItemRequest(r)
+	if err != nil {
+		h.Logger.Error("parse request", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var task *shared.Task
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		if task, err = txc.TaskRepository.GetByID(r.Context(), req.TaskID); err != nil {
+			return err
+		}
+
+		if err := shared.AuthorizeTask(r.Context(), task, shared.TaskRoleOwner, shared.TaskRoleEditor); err != nil {
+			return err
+		}
+
+		i := slices.IndexFunc(task.Items, func(item *shared.TaskItem) bool {
+			return item.ID == req.ID
+		})
+		if i < 0 {
+			return shared.ErrNotFound
+		}
+
+		j := i - 1
+		if req.Direction == "down" {
+			j = i + 1
+		}
+
+		// Moving the first item up or the last item down is a no-op.
+		if j < 0 || len(task.Items) <= j {
+			return nil
+		}
+
+		a, b := task.Items[i], task.Items[j]
+		a.Position, b.Position = b.Position, a.Position
+
+		if a.Position == b.Position {
+			a.Position, b.Position = j, i
+		}
+
+		for _, item := range []*shared.TaskItem{a, b} {
+			if err := txc.TaskItemRepository.Update(r.Context(), item); err != nil {
+				return err
+			}
+		}
+
+		task, err = txc.TaskRepository.GetByID(r.Context(), req.TaskID)
+		return err
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			http.Error(w, "task item not found", http.StatusNotFound)
+		} else if err == shared.ErrForbidden {
+			http.Error(w, "task cannot be modified", http.StatusForbidden)
+		} else {
+			h.Logger.Error("move task item", "error", err)
+			http.Error(w, "", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	vm := NewTaskItemsResponse(r, task)
+
+	h.Renderer.Render(w, "task_items.html", vm)
+}
@@ -0,0 +1,74 @@
+package ui
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+var ErrTaskMemberIsOwner = errors.New("member: the task is owned by this user")
+
+type PostUITaskMembers struct {
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
+}
+
+func (h *PostUITaskMembers) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseNewTaskMemberRequest(r)
+	if err != nil {
+		h.Logger.Error("parse request", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var task *shared.Task
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		if task, err = txc.TaskRepository.GetByID(r.Context(), req.TaskID); err != nil {
+			return err
+		}
+
+		if err := shared.AuthorizeTask(r.Context(), task, shared.TaskRoleOwner); err != nil {
+			return err
+		}
+
+		if req.UserID == task.UserID {
+			return ErrTaskMemberIsOwner
+		}
+
+		member := shared.NewTaskMember(req.TaskID, req.UserID, req.Email, req.Role)
+
+		if err := txc.TaskMemberRepository.Create(r.Context(), member); err != nil {
+			return err
+		}
+
+		event := shared.NewTaskEvent(task.ID, shared.TaskEventShared, shared.TaskEventData{Member: member.Identity(), Role: member.Role})
+
+		if err := txc.TaskEventRepository.Create(r.Context(), event); err != nil {
+			return err
+		}
+
+		task, err = txc.TaskRepository.GetByID(r.Context(), req.TaskID)
+		return err
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			http.Error(w, "task not found", http.StatusNotFound)
+		} else if err == shared.ErrForbidden {
+			http.Error(w, "only the owner can share the task", http.StatusForbidden)
+		} else if err == ErrTaskMemberIsOwner {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		} else {
+			h.Logger.Error("create task member", "error", err)
+			http.Error(w, "", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	vm := NewTaskMembersResponse(r, task)
+
+	h.Renderer.Render(w, "task_members.html", vm)
+}
@@ -9,44 +9,99 @@ import (
 type PostUITasks struct {
 	TxManager                 shared.TxManager
 	TaskAttachmentsRepository shared.TaskAttachmentsRepository
+	AttachmentLimits          *AttachmentLimits
 	Renderer                  Renderer
 	Logger                    *slog.Logger
 }
 
 func (h *PostUITasks) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := h.AttachmentLimits.LimitRequest(w, r); err != nil {
+		http.Error(w, err.Translate(GetTranslations(r)), http.StatusRequestEntityTooLarge)
+		return
+	}
+
 	req, err := ParseNewTaskRequest(r)
+	if limitErr := h.AttachmentLimits.RequestTooLarge(err); limitErr != nil {
+		http.Error(w, limitErr.Translate(GetTranslations(r)), http.StatusRequestEntityTooLarge)
+		return
+	}
 	if err != nil {
 		h.Logger.Error("parse request", "error", err)
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	task := shared.NewTask(req.Name, req.ExpiresAt)
+	task := shared.NewTask(req.Name, req.Description, req.Priority, req.ExpiresAt)
+	task.AutoComplete = req.AutoComplete
+	task.Assign(req.Assignee.UserID, req.Assignee.Email)
 
-	attachments := BuildAttachmentsUpdate(task.Attachments, req.Attachments.Names)
+	attachments, err := BuildAttachmentsUpdate(task.Attachments, req.Attachments.Names, req.Attachments.Files)
+	if err != nil {
+		h.Logger.Error("read task attachments", "error", err)
+		http.Error(w, "", http.StatusInternalServerError)
+		return
+	}
 
 	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		project, err := GetTaskProject(r.Context(), txc.ProjectRepository, req.ProjectID)
+		if err != nil {
+			return err
+		}
+
+		if project != nil {
+			task.SetProject(project)
+
+			if task.ExpiresAt == nil {
+				task.ExpiresAt = project.DefaultExpiresAt(task.CreatedAt)
+			}
+		}
+
+		task.SetRecurrence(req.Recurrence, GetTimezone(r))
+
 		if err = txc.TaskRepository.Create(r.Context(), task); err != nil {
 			return err
 		}
 
-		if err = txc.TaskRepository.UpdateAttachments(r.Context(), task.ID, attachments.Inserted, attachments.Deleted); err != nil {
+		if err = h.AttachmentLimits.Check(r.Context(), txc, task, attachments); err != nil {
+			return err
+		}
+
+		if err = txc.TaskRepository.UpdateAttachments(r.Context(), task.ID, attachments.Inserted, attachments.Updated, attachments.Deleted); err != nil {
+			return err
+		}
+
+		if err = txc.TaskRepository.UpdateTags(r.Context(), task.ID, req.Tags); err != nil {
 			return err
 		}
 
-		return h.TaskAttachmentsRepository.SaveAttachments(r.Context(), task.ID, req.Attachments.Files)
+		events := append(
+			[]*shared.TaskEvent{shared.NewTaskEvent(task.ID, shared.TaskEventCreated, shared.TaskEventData{})},
+			shared.NewTaskAttachmentEvents(task.ID, attachments.Inserted, attachments.Deleted)...,
+		)
+
+		if err = txc.TaskEventRepository.Create(r.Context(), events...); err != nil {
+			return err
+		}
+
+		return h.TaskAttachmentsRepository.SaveAttachments(r.Context(), task.ID, attachments.Uploads)
 	})
 
 	if err != nil {
-		h.Logger.Error("create task", "error", err)
-		http.Error(w, "", http.StatusInternalServerError)
+		if err == ErrProjectNotFound {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		} else if limitErr, ok := err.(*AttachmentLimitError); ok {
+			http.Error(w, limitErr.Translate(GetTranslations(r)), http.StatusUnprocessableEntity)
+		} else {
+			h.Logger.Error("create task", "error", err)
+			http.Error(w, "", http.StatusInternalServerError)
+		}
 		return
 	}
 
-	var tasks []*shared.Task
+	var page *shared.TaskPage
 
 	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
-		tasks, err = txc.TaskRepository.GetActive(r.Context(), 0, 50)
+		page, err = txc.TaskRepository.GetActive(r.Context(), GetTaskFilter(r), GetActiveSort(r), nil, TasksPageSize)
 		return err
 	})
 
@@ -56,7 +111,7 @@ func (h *PostUITasks) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	vm := NewTasksResponse(r, tasks)
+	vm := NewTasksResponse(r, page)
 
 	h.Renderer.Render(w, "active_tasks_table.html", vm)
 }
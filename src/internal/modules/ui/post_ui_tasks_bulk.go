@@ -0,0 +1,212 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"tasks-app/internal/shared"
+)
+
+// errBulkTasksFailed rolls back a bulk action that failed for some of the
+// selected tasks.
+var errBulkTasksFailed = errors.New("bulk action failed")
+
+type PostUITasksBulk struct {
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
+}
+
+func (h *PostUITasksBulk) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseBulkTasksRequest(r)
+	if err != nil {
+		h.Logger.Error("parse request", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var failures []*BulkTaskError
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		tasks, err := txc.TaskRepository.GetByIDs(r.Context(), req.IDs)
+		if err != nil {
+			return err
+		}
+
+		// Blockers are completed before the tasks they block, and the state
+		// of the blockers of the remaining tasks is kept up to date.
+		if req.Action == BulkActionComplete {
+			tasks = shared.OrderByBlockers(tasks)
+		}
+
+		for _, task := range tasks {
+			if err := h.apply(r.Context(), txc, req, task); err != nil {
+				if err != shared.ErrForbidden && err != shared.ErrBlocked {
+					return err
+				}
+
+				failures = append(failures, &BulkTaskError{task, err})
+				continue
+			}
+
+			if req.Action == BulkActionComplete {
+				for _, t := range tasks {
+					t.SetBlockerCompleted(task)
+				}
+			}
+		}
+
+		if len(failures) > 0 {
+			return errBulkTasksFailed
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		if err == errBulkTasksFailed {
+			h.writeFailures(w, failures)
+		} else if err == shared.ErrConflict {
+			http.Error(w, "a selected task was modified elsewhere", http.StatusConflict)
+		} else if err == ErrProjectNotFound {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		} else {
+			h.Logger.Error("update tasks", "error", err)
+			http.Error(w, "", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	var page *shared.TaskPage
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		if req.Filter == TaskFilterCompleted {
+			page, err = txc.TaskRepository.GetCompleted(r.Context(), GetTaskFilter(r), GetCompletedSort(r), nil, TasksPageSize)
+		} else {
+			page, err = txc.TaskRepository.GetActive(r.Context(), GetTaskFilter(r), GetActiveSort(r), nil, TasksPageSize)
+		}
+		return err
+	})
+
+	if err != nil {
+		h.Logger.Error("get tasks", "error", err)
+		http.Error(w, "", http.StatusInternalServerError)
+		return
+	}
+
+	vm := NewTasksResponse(r, page)
+
+	if req.Filter == TaskFilterCompleted {
+		h.Renderer.Render(w, "completed_tasks_table.html", vm)
+	} else {
+		h.Renderer.Render(w, "active_tasks_table.html", vm)
+	}
+}
+
+// writeFailures reports the tasks that the action failed for, one per line.
+// The response is forbidden only if every failure is.
+func (h *PostUITasksBulk) writeFailures(w http.ResponseWriter, failures []*BulkTaskError) {
+	status := http.StatusForbidden
+	lines := make([]string, len(failures))
+
+	for i, f := range failures {
+		if f.Err != shared.ErrForbidden {
+			status = http.StatusUnprocessableEntity
+		}
+		lines[i] = f.Error()
+	}
+
+	http.Error(w, strings.Join(lines, "\n"), status)
+}
+
+func (h *PostUITasksBulk) apply(ctx context.Context, txc shared.TxContext, req *BulkTasksRequest, task *shared.Task) error {
+	roles := []shared.TaskRole{shared.TaskRoleOwner, shared.TaskRoleEditor}
+	if req.Action == BulkActionDelete {
+		roles = []shared.TaskRole{shared.TaskRoleOwner}
+	}
+
+	if err := shared.AuthorizeTask(ctx, task, roles...); err != nil {
+		return err
+	}
+
+	switch req.Action {
+	case BulkActionComplete:
+		if task.CompletedAt != nil {
+			return nil
+		}
+
+		if task.IsBlocked() {
+			return shared.ErrBlocked
+		}
+
+		task.SetCompleted()
+
+		if _, err := shared.CreateNextOccurrence(ctx, txc, task); err != nil {
+			return err
+		}
+
+		if err := txc.TaskRepository.Update(ctx, task); err != nil {
+			return err
+		}
+
+		if err := shared.UnblockDependents(ctx, txc, task); err != nil {
+			return err
+		}
+
+		return txc.TaskEventRepository.Create(ctx, shared.NewTaskEvent(task.ID, shared.TaskEventCompleted, shared.TaskEventData{}))
+
+	case BulkActionDelete:
+		task.SetDeleted()
+
+		if err := txc.TaskRepository.Update(ctx, task); err != nil {
+			return err
+		}
+
+		if err := shared.UnblockDependents(ctx, txc, task); err != nil {
+			return err
+		}
+
+		return txc.TaskEventRepository.Create(ctx, shared.NewTaskEvent(task.ID, shared.TaskEventDeleted, shared.TaskEventData{}))
+
+	case BulkActionReschedule:
+		if task.ExpiresAt == nil {
+			return nil
+		}
+
+		old := *task
+		expiresAt := task.ExpiresAt.AddDate(0, 0, req.Days)
+
+		task.Update(task.Name, task.Description, task.Priority, &expiresAt)
+
+		if err := txc.TaskRepository.Update(ctx, task); err != nil {
+			return err
+		}
+
+		return txc.TaskEventRepository.Create(ctx, shared.NewTaskUpdateEvents(old, task)...)
+
+	case BulkActionTag:
+		names := task.Tags.Names()
+		if slices.Contains(names, req.Tag) {
+			return nil
+		}
+
+		return txc.TaskRepository.UpdateTags(ctx, task.ID, append(names, req.Tag))
+
+	case BulkActionMove:
+		event, err := MoveTask(ctx, txc, task, req.ProjectID)
+		if err != nil || event == nil {
+			return err
+		}
+
+		if err := txc.TaskRepository.Update(ctx, task); err != nil {
+			return err
+		}
+
+		return txc.TaskEventRepository.Create(ctx, event)
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"tasks-app/internal/shared"
+)
+
+const CookieNameProject = "project"
+
+var ErrProjectNotFound = errors.New("project: not found")
+
+// SetProjectCookie selects the project of the task lists. Project 0 selects
+// all tasks.
+func SetProjectCookie(w http.ResponseWriter, projectID int) {
+	cookie := &http.Cookie{
+		Name:     CookieNameProject,
+		Value:    strconv.Itoa(projectID),
+		Path:     "/",
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+		MaxAge:   365 * 24 * 60 * 60, // One year in seconds
+	}
+
+	if projectID == 0 {
+		cookie.Value = ""
+		cookie.MaxAge = -1
+	}
+
+	http.SetCookie(w, cookie)
+}
+
+func GetProject(r *http.Request) int {
+	cookie, err := r.Cookie(CookieNameProject)
+	if err != nil {
+		return 0
+	}
+
+	if v, err := strconv.Atoi(cookie.Value); err == nil && 0 < v {
+		return v
+	}
+
+	return 0
+}
+
+// GetTaskProject returns the project of the current user with the ID, or nil
+// when the ID is nil.
+func GetTaskProject(ctx context.Context, repo shared.ProjectRepository, projectID *int) (*shared.Project, error) {
+	if projectID == nil {
+		return nil, nil
+	}
+
+	project, err := repo.GetByID(ctx, *projectID)
+	if err == shared.ErrNotFound {
+		return nil, ErrProjectNotFound
+	}
+
+	return project, err
+}
+
+// MoveTask moves the task to a project of the current user and returns the
+// event of the move, or nil when the task is already in the project. Only the
+// owner organizes a task into projects.
+func MoveTask(ctx context.Context, txc shared.TxContext, task *shared.Task, projectID *int) (*shared.TaskEvent, error) {
+	if task.IsInProject(projectID) {
+		return nil, nil
+	}
+
+	if err := shared.AuthorizeTask(ctx, task, shared.TaskRoleOwner); err != nil {
+		return nil, err
+	}
+
+	project, err := GetTaskProject(ctx, txc.ProjectRepository, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	task.SetProject(project)
+
+	data := shared.TaskEventData{}
+	if project != nil {
+		data.Project = project.Name
+	}
+
+	return shared.NewTaskEvent(task.ID, shared.TaskEventMoved, data), nil
+}
+
+// FindProject returns the project with the ID from the projects, or nil.
+func FindProject(projects []*shared.Project, projectID int) *shared.Project {
+	for _, p := range projects {
+		if p.ID == projectID {
+			return p
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type PutAPITask struct {
+	TxManager shared.TxManager
+	Logger    *slog.Logger
+}
+
+func (h *PutAPITask) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseAPIUpdateTaskRequest(r)
+	if err != nil {
+		WriteProblem(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var task *shared.Task
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		if task, err = txc.TaskRepository.GetByID(r.Context(), req.ID); err != nil {
+			return err
+		}
+
+		if err := shared.AuthorizeTask(r.Context(), task, shared.TaskRoleOwner, shared.TaskRoleEditor); err != nil {
+			return err
+		}
+
+		if !IfMatch(r, TaskETag(task)) {
+			return ErrPreconditionFailed
+		}
+
+		old := *task
+
+		task.Update(req.Name, req.Description, req.Priority, req.ExpiresAt)
+		task.SetRecurrence(req.Recurrence, req.Timezone)
+		task.AutoComplete = req.AutoComplete
+
+		if err := AssignTask(r.Context(), task, req.Assignee); err != nil {
+			return err
+		}
+
+		events := shared.NewTaskUpdateEvents(old, task)
+
+		event, err := MoveTask(r.Context(), txc, task, req.ProjectID)
+		if err != nil {
+			return err
+		}
+
+		if event != nil {
+			events = append(events, event)
+		}
+
+		if err := txc.TaskRepository.Update(r.Context(), task); err != nil {
+			return err
+		}
+
+		if err := txc.TaskRepository.UpdateTags(r.Context(), task.ID, req.Tags); err != nil {
+			return err
+		}
+
+		if err := txc.TaskEventRepository.Create(r.Context(), events...); err != nil {
+			return err
+		}
+
+		task, err = txc.TaskRepository.GetByID(r.Context(), req.ID)
+		return err
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			WriteProblem(w, http.StatusNotFound, "task not found")
+		} else if err == shared.ErrForbidden {
+			WriteProblem(w, http.StatusForbidden, "task cannot be modified")
+		} else if err == ErrProjectNotFound {
+			WriteProblem(w, http.StatusBadRequest, "project_id: not found")
+		} else if err == ErrPreconditionFailed {
+			WriteProblem(w, http.StatusPreconditionFailed, "task has been modified")
+		} else if err == shared.ErrConflict {
+			WriteProblem(w, http.StatusConflict, "task was modified concurrently")
+		} else {
+			h.Logger.Error("update task", "error", err)
+			WriteProblem(w, http.StatusInternalServerError, "")
+		}
+		return
+	}
+
+	WriteTaskJSON(w, http.StatusOK, task)
+}
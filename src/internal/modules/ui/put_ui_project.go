@@ -0,0 +1,56 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type PutUIProject struct {
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
+}
+
+func (h *PutUIProject) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseUpdateProjectRequest(r)
+	if err != nil {
+		h.Logger.Error("parse request", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var projects []*shared.Project
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		project, err := txc.ProjectRepository.GetByID(r.Context(), req.ID)
+		if err != nil {
+			return err
+		}
+
+		project.Update(req.Name, req.ExpirationDays)
+
+		if err := txc.ProjectRepository.Update(r.Context(), project); err != nil {
+			return err
+		}
+
+		projects, err = txc.ProjectRepository.GetAll(r.Context())
+		return err
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			http.Error(w, "project not found", http.StatusNotFound)
+		} else if err == shared.ErrDuplicate {
+			http.Error(w, "name: a project with this name already exists", http.StatusConflict)
+		} else {
+			h.Logger.Error("update project", "error", err)
+			http.Error(w, "", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	vm := NewProjectsResponse(r, projects)
+
+	h.Renderer.Render(w, "projects_table.html", vm)
+}
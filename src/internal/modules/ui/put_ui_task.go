@@ -9,12 +9,22 @@ import (
 type PutUITask struct {
 	TxManager                 shared.TxManager
 	TaskAttachmentsRepository shared.TaskAttachmentsRepository
+	AttachmentLimits          *AttachmentLimits
 	Renderer                  Renderer
 	Logger                    *slog.Logger
 }
 
 func (h *PutUITask) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := h.AttachmentLimits.LimitRequest(w, r); err != nil {
+		http.Error(w, err.Translate(GetTranslations(r)), http.StatusRequestEntityTooLarge)
+		return
+	}
+
 	req, err := ParseUpdateTaskRequest(r)
+	if limitErr := h.AttachmentLimits.RequestTooLarge(err); limitErr != nil {
+		http.Error(w, limitErr.Translate(GetTranslations(r)), http.StatusRequestEntityTooLarge)
+		return
+	}
 	if err != nil {
 		h.Logger.Error("parse request", "error", err)
 		http.Error(w, err.Error(), http.StatusBadRequest)
@@ -22,38 +32,88 @@ func (h *PutUITask) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var task *shared.Task
+	var attachments *AttachmentsUpdate
 
 	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
 		if task, err = txc.TaskRepository.GetByID(r.Context(), req.ID); err != nil {
 			return err
 		}
 
-		task.Update(req.Name, req.ExpiresAt)
+		if err := shared.AuthorizeTask(r.Context(), task, shared.TaskRoleOwner, shared.TaskRoleEditor); err != nil {
+			return err
+		}
+
+		if task.Version != req.Version {
+			return shared.ErrConflict
+		}
+
+		old := *task
+
+		task.Update(req.Name, req.Description, req.Priority, req.ExpiresAt)
+		task.SetRecurrence(req.Recurrence, GetTimezone(r))
+		task.AutoComplete = req.AutoComplete
+
+		if err := AssignTask(r.Context(), task, req.Assignee); err != nil {
+			return err
+		}
+
+		events := shared.NewTaskUpdateEvents(old, task)
+
+		if req.MoveProject {
+			event, err := MoveTask(r.Context(), txc, task, req.ProjectID)
+			if err != nil {
+				return err
+			}
+
+			if event != nil {
+				events = append(events, event)
+			}
+		}
+
+		if attachments, err = BuildAttachmentsUpdate(task.Attachments, req.Attachments.Names, req.Attachments.Files); err != nil {
+			return err
+		}
 
-		attachments := BuildAttachmentsUpdate(task.Attachments, req.Attachments.Names)
+		if err := h.AttachmentLimits.Check(r.Context(), txc, task, attachments); err != nil {
+			return err
+		}
 
 		if err := txc.TaskRepository.Update(r.Context(), task); err != nil {
 			return err
 		}
 
-		if err := txc.TaskRepository.UpdateAttachments(r.Context(), task.ID, attachments.Inserted, attachments.Deleted); err != nil {
+		if err := txc.TaskRepository.UpdateAttachments(r.Context(), task.ID, attachments.Inserted, attachments.Updated, attachments.Deleted); err != nil {
 			return err
 		}
 
-		if task, err = txc.TaskRepository.GetByID(r.Context(), req.ID); err != nil {
+		if err := txc.TaskRepository.UpdateTags(r.Context(), task.ID, req.Tags); err != nil {
 			return err
 		}
 
-		if err := h.TaskAttachmentsRepository.SaveAttachments(r.Context(), task.ID, req.Attachments.Files); err != nil {
+		events = append(events, shared.NewTaskAttachmentEvents(task.ID, attachments.Inserted, attachments.Deleted)...)
+
+		if err := txc.TaskEventRepository.Create(r.Context(), events...); err != nil {
+			return err
+		}
+
+		if task, err = txc.TaskRepository.GetByID(r.Context(), req.ID); err != nil {
 			return err
 		}
 
-		return h.TaskAttachmentsRepository.DeleteAttachments(r.Context(), task.ID, attachments.Deleted)
+		return h.TaskAttachmentsRepository.SaveAttachments(r.Context(), task.ID, attachments.Uploads)
 	})
 
 	if err != nil {
 		if err == shared.ErrNotFound {
 			http.Error(w, "task not found", http.StatusNotFound)
+		} else if err == shared.ErrForbidden {
+			http.Error(w, "task cannot be modified", http.StatusForbidden)
+		} else if err == ErrProjectNotFound {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		} else if limitErr, ok := err.(*AttachmentLimitError); ok {
+			http.Error(w, limitErr.Translate(GetTranslations(r)), http.StatusUnprocessableEntity)
+		} else if err == shared.ErrConflict {
+			h.writeConflict(w, r, req)
 		} else {
 			h.Logger.Error("update task", "error", err)
 			http.Error(w, "", http.StatusInternalServerError)
@@ -61,7 +121,36 @@ func (h *PutUITask) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := h.TaskAttachmentsRepository.DeleteAttachments(r.Context(), task.ID, attachments.Obsolete()); err != nil {
+		h.Logger.Error("delete task attachments", "error", err)
+	}
+
 	vm := NewTaskResponse(r, task)
 
 	h.Renderer.Render(w, "active_tasks_table_row.html", vm)
 }
+
+// writeConflict shows the latest version of the task, since the task of the
+// failed transaction already has the submitted values applied.
+func (h *PutUITask) writeConflict(w http.ResponseWriter, r *http.Request, req *UpdateTaskRequest) {
+	var task *shared.Task
+	var err error
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		task, err = txc.TaskRepository.GetByID(r.Context(), req.ID)
+		return err
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			http.Error(w, "task not found", http.StatusNotFound)
+		} else {
+			h.Logger.Error("get task", "error", err)
+			http.Error(w, "", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusConflict)
+	h.Renderer.Render(w, "active_tasks_table_row_conflict.html", NewTaskConflictResponse(r, task, req))
+}
@@ -0,0 +1,91 @@
+package ui
+
+import (
+	"log/slog"
+	"net/http"
+	"tasks-app/internal/shared"
+)
+
+type PutUITaskItem struct {
+	TxManager shared.TxManager
+	Renderer  Renderer
+	Logger    *slog.Logger
+}
+
+func (h *PutUITaskItem) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := ParseUpdateTaskItemRequest(r)
+	if err != nil {
+		h.Logger.Error("parse request", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var task *shared.Task
+	var completed bool
+
+	err = h.TxManager.RunInTx(func(txc shared.TxContext) error {
+		if task, err = txc.TaskRepository.GetByID(r.Context(), req.TaskID); err != nil {
+			return err
+		}
+
+		if err := shared.AuthorizeTask(r.Context(), task, shared.TaskRoleOwner, shared.TaskRoleEditor); err != nil {
+			return err
+		}
+
+		item, err := txc.TaskItemRepository.GetByID(r.Context(), req.TaskID, req.ID)
+		if err != nil {
+			return err
+		}
+
+		item.Update(req.Name, req.Completed)
+
+		if err := txc.TaskItemRepository.Update(r.Context(), item); err != nil {
+			return err
+		}
+
+		if task, err = txc.TaskRepository.GetByID(r.Context(), req.TaskID); err != nil {
+			return err
+		}
+
+		if !task.ShouldAutoComplete() {
+			return nil
+		}
+
+		task.SetCompleted()
+		completed = true
+
+		if _, err := shared.CreateNextOccurrence(r.Context(), txc, task); err != nil {
+			return err
+		}
+
+		if err := txc.TaskRepository.Update(r.Context(), task); err != nil {
+			return err
+		}
+
+		if err := shared.UnblockDependents(r.Context(), txc, task); err != nil {
+			return err
+		}
+
+		return txc.TaskEventRepository.Create(r.Context(), shared.NewTaskEvent(task.ID, shared.TaskEventCompleted, shared.TaskEventData{}))
+	})
+
+	if err != nil {
+		if err == shared.ErrNotFound {
+			http.Error(w, "task item not found", http.StatusNotFound)
+		} else if err == shared.ErrForbidden {
+			http.Error(w, "task cannot be modified", http.StatusForbidden)
+		} else {
+			h.Logger.Error("update task item", "error", err)
+			http.Error(w, "", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	if completed {
+		w.Header().Set("HX-Trigger", "taskCompleted")
+	}
+
+	vm := NewTaskItemsResponse(r, task)
+
+	h.Renderer.Render(w, "task_items.html", vm)
+}
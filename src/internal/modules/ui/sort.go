@@ -0,0 +1,50 @@
+package ui
+
+import (
+	"net/http"
+	"slices"
+	"tasks-app/internal/shared"
+)
+
+const (
+	CookieNameActiveSort    = "sort_active"
+	CookieNameCompletedSort = "sort_completed"
+)
+
+func IsValidSort(sort shared.TaskSort, supported []shared.TaskSort) bool {
+	return slices.Contains(supported, sort)
+}
+
+func SetSortCookie(w http.ResponseWriter, name string, sort shared.TaskSort) {
+	cookie := &http.Cookie{
+		Name:     name,
+		Value:    string(sort),
+		Path:     "/",
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+		MaxAge:   365 * 24 * 60 * 60, // One year in seconds
+	}
+	http.SetCookie(w, cookie)
+}
+
+func GetSort(r *http.Request, name string, supported []shared.TaskSort) shared.TaskSort {
+	cookie, err := r.Cookie(name)
+	if err != nil {
+		return supported[0]
+	}
+
+	if sort := shared.TaskSort(cookie.Value); IsValidSort(sort, supported) {
+		return sort
+	}
+
+	return supported[0]
+}
+
+func GetActiveSort(r *http.Request) shared.TaskSort {
+	return GetSort(r, CookieNameActiveSort, shared.SupportedActiveTaskSorts)
+}
+
+func GetCompletedSort(r *http.Request) shared.TaskSort {
+	return GetSort(r, CookieNameCompletedSort, shared.SupportedCompletedTaskSorts)
+}
@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"net/http"
+	"strings"
+	"tasks-app/internal/shared"
+)
+
+const (
+	TasksPageSize       = 50
+	TasksExportPageSize = 1000
+)
+
+func GetTagFilter(r *http.Request) string {
+	return strings.TrimSpace(r.FormValue("tag"))
+}
+
+// GetAssignedFilter tells whether the request lists only the tasks assigned
+// to the current user.
+func GetAssignedFilter(r *http.Request) bool {
+	return r.FormValue("assigned") == "me"
+}
+
+// GetTaskFilter returns the filter of the task lists: the tag and assignee of
+// the request and the project selected in the list switcher.
+func GetTaskFilter(r *http.Request) shared.TaskFilter {
+	return shared.TaskFilter{
+		Tag:          GetTagFilter(r),
+		ProjectID:    GetProject(r),
+		AssignedToMe: GetAssignedFilter(r),
+	}
+}
+
+// GetAllTasks reads a task list page by page until the last page.
+func GetAllTasks(get func(cursor *shared.TaskCursor) (*shared.TaskPage, error)) ([]*shared.Task, error) {
+	var tasks []*shared.Task
+	var cursor *shared.TaskCursor
+
+	for {
+		page, err := get(cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		tasks = append(tasks, page.Tasks...)
+
+		if page.Next == nil {
+			return tasks, nil
+		}
+
+		cursor = page.Next
+	}
+}
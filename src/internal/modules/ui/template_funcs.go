@@ -2,7 +2,10 @@ package ui
 
 import (
 	"errors"
+	"fmt"
+	"html/template"
 	"strings"
+	"tasks-app/internal/shared"
 	"time"
 )
 
@@ -57,3 +60,46 @@ func FormatTimezone(tz string) string {
 	}
 	return strings.ReplaceAll(parts[1], "_", " ")
 }
+
+// FormatSize formats a size in bytes with a binary unit prefix.
+func FormatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
+func Markdown(src string) (template.HTML, error) {
+	html, err := shared.RenderMarkdown(src)
+	if err != nil {
+		return "", err
+	}
+	return template.HTML(html), nil
+}
+
+func Highlight(s string) template.HTML {
+	return template.HTML(HighlightHTML(s))
+}
+
+func HighlightHTML(s string) string {
+	s = template.HTMLEscapeString(s)
+	s = strings.ReplaceAll(s, shared.HighlightStart, "<mark>")
+	return strings.ReplaceAll(s, shared.HighlightStop, "</mark>")
+}
+
+func RecurrencePreset(rule string) string {
+	for _, p := range shared.RecurrencePresets {
+		if p.Rule == rule {
+			return p.Name
+		}
+	}
+	return ""
+}
@@ -5,6 +5,7 @@ import (
 	"html/template"
 	"io"
 	"log/slog"
+	"net/url"
 )
 
 //go:embed templates
@@ -20,10 +21,15 @@ var _ Renderer = (*TemplateRenderer)(nil)
 func NewTemplateRenderer(logger *slog.Logger) (*TemplateRenderer, error) {
 	templates, err := template.New("").
 		Funcs(template.FuncMap{
-			"dict":           Dict,
-			"formattime":     FormatTime,
-			"formatisotime":  FormatISOTime,
-			"formattimezone": FormatTimezone,
+			"dict":             Dict,
+			"formattime":       FormatTime,
+			"formatisotime":    FormatISOTime,
+			"formattimezone":   FormatTimezone,
+			"formatsize":       FormatSize,
+			"highlight":        Highlight,
+			"markdown":         Markdown,
+			"pathescape":       url.PathEscape,
+			"recurrencepreset": RecurrencePreset,
 		}).
 		ParseFS(templatesFS, "templates/*.html")
 
@@ -0,0 +1,13 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"tasks-app/internal/shared"
+)
+
+var ErrInvalidToken = errors.New("invalid token")
+
+type TokenResolver interface {
+	ResolveToken(ctx context.Context, token string) (*shared.UserContext, error)
+}
@@ -5,11 +5,16 @@ import (
 	"fmt"
 	"mime/multipart"
 	"net/http"
+	"net/mail"
 	"slices"
 	"strconv"
 	"strings"
 	"tasks-app/internal/shared"
 	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
 )
 
 type LanguageRequest struct {
@@ -24,6 +29,14 @@ type TimezoneRequest struct {
 	Timezone string
 }
 
+type SortRequest struct {
+	Sort shared.TaskSort
+}
+
+type SearchRequest struct {
+	Query string
+}
+
 type TaskRequest struct {
 	ID int
 }
@@ -33,17 +46,134 @@ type TaskAttachmentRequest struct {
 	Name string
 }
 
+type TaskItemRequest struct {
+	TaskID int
+	ID     int
+}
+
+type NewTaskItemRequest struct {
+	TaskID int
+	Name   string
+}
+
+type UpdateTaskItemRequest struct {
+	TaskID    int
+	ID        int
+	Name      string
+	Completed bool
+}
+
+type MoveTaskItemRequest struct {
+	TaskID    int
+	ID        int
+	Direction string
+}
+
+type TaskCommentRequest struct {
+	TaskID int
+	ID     int
+}
+
+type NewTaskCommentRequest struct {
+	TaskID int
+	Body   string
+}
+
+type UpdateTaskCommentRequest struct {
+	TaskID int
+	ID     int
+	Body   string
+}
+
+type TaskBlockerRequest struct {
+	TaskID    int
+	BlockerID int
+}
+
+type TaskMemberRequest struct {
+	TaskID int
+	ID     int
+}
+
+type NewTaskMemberRequest struct {
+	TaskID int
+	UserID string
+	Email  string
+	Role   shared.TaskRole
+}
+
 type NewTaskRequest struct {
-	Name        string
-	ExpiresAt   *time.Time
-	Attachments *AttachmentsRequest
+	Name         string
+	Description  string
+	Priority     shared.Priority
+	Tags         []string
+	ExpiresAt    *time.Time
+	Recurrence   string
+	AutoComplete bool
+	Attachments  *AttachmentsRequest
+	ProjectID    *int
+	Assignee     *AssigneeRequest
 }
 
 type UpdateTaskRequest struct {
-	ID          int
-	Name        string
-	ExpiresAt   *time.Time
-	Attachments *AttachmentsRequest
+	ID           int
+	Version      int
+	Name         string
+	Description  string
+	Priority     shared.Priority
+	Tags         []string
+	ExpiresAt    *time.Time
+	Recurrence   string
+	AutoComplete bool
+	Attachments  *AttachmentsRequest
+	MoveProject  bool
+	ProjectID    *int
+	Assignee     *AssigneeRequest
+}
+
+// AssigneeRequest identifies the assignee of a task either by user ID or by
+// email address. Both are empty when the task is unassigned.
+type AssigneeRequest struct {
+	UserID string
+	Email  string
+}
+
+type APITokenRequest struct {
+	ID int
+}
+
+type BulkTasksRequest struct {
+	Action    string
+	IDs       []int
+	Filter    string
+	Days      int
+	Tag       string
+	ProjectID *int
+}
+
+type ProjectRequest struct {
+	ID int
+}
+
+type SetProjectRequest struct {
+	ProjectID int
+}
+
+type NewProjectRequest struct {
+	Name           string
+	ExpirationDays int
+}
+
+type UpdateProjectRequest struct {
+	ID             int
+	Name           string
+	ExpirationDays int
+}
+
+type NewAPITokenRequest struct {
+	Name      string
+	Scopes    []string
+	ExpiresAt *time.Time
 }
 
 type AttachmentsRequest struct {
@@ -54,60 +184,257 @@ type AttachmentsRequest struct {
 type TasksResponse struct {
 	UI            *UIModel
 	Tasks         []*shared.Task
+	Next          *shared.TaskCursor
+	Sort          shared.TaskSort
+	Sorts         []shared.TaskSort
+	Tag           string
+	Tags          []*shared.Tag
+	Project       *shared.Project
+	Projects      []*shared.Project
+	AssignedToMe  bool
+	Priorities    []shared.Priority
+	Recurrences   []shared.RecurrencePreset
 	IsCreatingNew bool
 }
 
 type TaskResponse struct {
+	UI          *UIModel
+	Task        *shared.Task
+	Tags        []*shared.Tag
+	Projects    []*shared.Project
+	Priorities  []shared.Priority
+	Recurrences []shared.RecurrencePreset
+}
+
+// TaskConflictResponse shows the latest version of a task next to the values
+// that were submitted for an older version.
+type TaskConflictResponse struct {
+	UI        *UIModel
+	Task      *shared.Task
+	Submitted *UpdateTaskRequest
+}
+
+type SearchResponse struct {
+	UI      *UIModel
+	Query   string
+	Results []*shared.TaskSearchResult
+}
+
+type TaskItemsResponse struct {
+	UI   *UIModel
+	Task *shared.Task
+	Open bool
+}
+
+type TaskBlockersResponse struct {
+	UI         *UIModel
+	Task       *shared.Task
+	Candidates []*shared.Task
+}
+
+type TaskMembersResponse struct {
 	UI   *UIModel
 	Task *shared.Task
+	Open bool
+}
+
+type TaskCommentsResponse struct {
+	UI       *UIModel
+	Task     *shared.Task
+	Comments []*shared.TaskComment
+}
+
+type TaskEventsResponse struct {
+	UI     *UIModel
+	Events []*shared.TaskEvent
+}
+
+type TaskCountsResponse struct {
+	UI              *UIModel
+	Counts          *shared.TaskCounts
+	AttachmentUsage int64
+	AttachmentQuota int64
+}
+
+type APITokensResponse struct {
+	UI        *UIModel
+	Tokens    []*shared.APIToken
+	Scopes    []string
+	ExpiresIn []int
+	NewToken  string
+}
+
+type ProjectsResponse struct {
+	UI       *UIModel
+	Project  *shared.Project
+	Projects []*shared.Project
 }
 
 type UIModel struct {
-	Title     string
-	Theme     string
-	Language  string
-	Languages []string
-	T         map[string]string
-	Location  *time.Location
-	Timezones []string
-	UserID    string
-	UserName  string
+	Title             string
+	Theme             string
+	Language          string
+	Languages         []string
+	T                 map[string]string
+	Location          *time.Location
+	Timezones         []string
+	UserID            string
+	UserName          string
+	UserEmail         string
+	UserEmailVerified bool
+	ProjectID         int
 }
 
 func NewUIModel(r *http.Request) *UIModel {
-	var userID, userName string
+	var userID, userName, userEmail string
+	var userEmailVerified bool
 	user, _ := shared.GetUserContext(r.Context())
 	if user != nil {
 		userID = user.ID
 		userName = user.Name
+		userEmail = user.Email
+		userEmailVerified = user.EmailVerified
 	}
 
-	language := GetLanguage(r)
-
 	return &UIModel{
-		Title:     "",
-		Theme:     GetTheme(r),
-		Language:  language,
-		Languages: SupportedLanguages,
-		T:         Translations[language],
-		Location:  GetLocation(r),
-		Timezones: SupportedTimezones,
-		UserID:    userID,
-		UserName:  userName,
+		Title:             "",
+		Theme:             GetTheme(r),
+		Language:          GetLanguage(r),
+		Languages:         SupportedLanguages,
+		T:                 GetTranslations(r),
+		Location:          GetLocation(r),
+		Timezones:         SupportedTimezones,
+		UserID:            userID,
+		UserName:          userName,
+		UserEmail:         userEmail,
+		UserEmailVerified: userEmailVerified,
+		ProjectID:         GetProject(r),
 	}
 }
 
-func NewTasksResponse(r *http.Request, tasks []*shared.Task) *TasksResponse {
+// IsAssignedToMe tells whether the task is assigned to the current user.
+func (m *UIModel) IsAssignedToMe(task *shared.Task) bool {
+	return task.IsAssignedTo(m.userContext())
+}
+
+// TaskRole returns the role of the current user in the task.
+func (m *UIModel) TaskRole(task *shared.Task) shared.TaskRole {
+	return task.RoleOf(m.userContext())
+}
+
+func (m *UIModel) userContext() *shared.UserContext {
+	return &shared.UserContext{ID: m.UserID, Email: m.UserEmail, EmailVerified: m.UserEmailVerified}
+}
+
+func NewTasksResponse(r *http.Request, page *shared.TaskPage) *TasksResponse {
 	return &TasksResponse{
-		UI:    NewUIModel(r),
-		Tasks: tasks,
+		UI:           NewUIModel(r),
+		Tasks:        page.Tasks,
+		Next:         page.Next,
+		Tag:          GetTagFilter(r),
+		AssignedToMe: GetAssignedFilter(r),
+		Priorities:   shared.SupportedPriorities,
+		Recurrences:  shared.RecurrencePresets,
 	}
 }
 
 func NewTaskResponse(r *http.Request, task *shared.Task) *TaskResponse {
 	return &TaskResponse{
+		UI:          NewUIModel(r),
+		Task:        task,
+		Priorities:  shared.SupportedPriorities,
+		Recurrences: shared.RecurrencePresets,
+	}
+}
+
+func NewTaskConflictResponse(r *http.Request, task *shared.Task, submitted *UpdateTaskRequest) *TaskConflictResponse {
+	return &TaskConflictResponse{
+		UI:        NewUIModel(r),
+		Task:      task,
+		Submitted: submitted,
+	}
+}
+
+func NewSearchResponse(r *http.Request, query string, results []*shared.TaskSearchResult) *SearchResponse {
+	return &SearchResponse{
+		UI:      NewUIModel(r),
+		Query:   query,
+		Results: results,
+	}
+}
+
+func NewTaskItemsResponse(r *http.Request, task *shared.Task) *TaskItemsResponse {
+	return &TaskItemsResponse{
+		UI:   NewUIModel(r),
+		Task: task,
+		Open: true,
+	}
+}
+
+func NewTaskBlockersResponse(r *http.Request, task *shared.Task, candidates []*shared.Task) *TaskBlockersResponse {
+	return &TaskBlockersResponse{
+		UI:         NewUIModel(r),
+		Task:       task,
+		Candidates: candidates,
+	}
+}
+
+func NewTaskMembersResponse(r *http.Request, task *shared.Task) *TaskMembersResponse {
+	return &TaskMembersResponse{
 		UI:   NewUIModel(r),
 		Task: task,
+		Open: true,
+	}
+}
+
+func NewTaskCommentsResponse(r *http.Request, task *shared.Task, comments []*shared.TaskComment) *TaskCommentsResponse {
+	return &TaskCommentsResponse{
+		UI:       NewUIModel(r),
+		Task:     task,
+		Comments: comments,
+	}
+}
+
+func NewTaskEventsResponse(r *http.Request, events []*shared.TaskEvent) *TaskEventsResponse {
+	return &TaskEventsResponse{
+		UI:     NewUIModel(r),
+		Events: events,
+	}
+}
+
+func NewTaskCountsResponse(r *http.Request, counts *shared.TaskCounts) *TaskCountsResponse {
+	return &TaskCountsResponse{
+		UI:     NewUIModel(r),
+		Counts: counts,
+	}
+}
+
+// AttachmentUsagePercent returns the used share of the attachment quota, or
+// zero if there is no quota.
+func (vm *TaskCountsResponse) AttachmentUsagePercent() int64 {
+	if vm.AttachmentQuota <= 0 {
+		return 0
+	}
+
+	return min(100, vm.AttachmentUsage*100/vm.AttachmentQuota)
+}
+
+func NewProjectsResponse(r *http.Request, projects []*shared.Project) *ProjectsResponse {
+	vm := &ProjectsResponse{
+		UI:       NewUIModel(r),
+		Projects: projects,
+	}
+	vm.Project = FindProject(projects, vm.UI.ProjectID)
+
+	return vm
+}
+
+func NewAPITokensResponse(r *http.Request, tokens []*shared.APIToken) *APITokensResponse {
+	return &APITokensResponse{
+		UI:        NewUIModel(r),
+		Tokens:    tokens,
+		Scopes:    shared.SupportedScopes,
+		ExpiresIn: SupportedAPITokenExpiresIn,
 	}
 }
 
@@ -156,6 +483,36 @@ func ParseSetTimezoneRequest(r *http.Request) (*TimezoneRequest, error) {
 	return &TimezoneRequest{tz}, nil
 }
 
+func ParseSortRequest(r *http.Request, supported []shared.TaskSort) (*SortRequest, error) {
+	var errs []error
+
+	sort, err := ParseSort(r.FormValue("sort"), supported)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &SortRequest{sort}, nil
+}
+
+func ParseSearchRequest(r *http.Request) (*SearchRequest, error) {
+	var errs []error
+
+	query, err := ParseSearchQuery(r.FormValue("q"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &SearchRequest{query}, nil
+}
+
 func ParseTaskRequest(r *http.Request) (*TaskRequest, error) {
 	var errs []error
 
@@ -191,24 +548,35 @@ func ParseTaskAttachmentRequest(r *http.Request) (*TaskAttachmentRequest, error)
 	return &TaskAttachmentRequest{id, name}, nil
 }
 
-func ParseNewTaskRequest(r *http.Request) (*NewTaskRequest, error) {
-	if err := r.ParseMultipartForm(1 << 27); err != nil {
-		return nil, errors.New("attachments: payload size exceeds limit")
-	}
-
+func ParseTaskItemRequest(r *http.Request) (*TaskItemRequest, error) {
 	var errs []error
 
-	name, err := ParseTaskName(r.FormValue("name"))
+	taskID, err := ParseTaskID(r.PathValue("id"))
 	if err != nil {
 		errs = append(errs, err)
 	}
 
-	expiresAt, err := ParseTaskExpiresAt(r.FormValue("expires_at"), GetLocation(r))
+	id, err := ParseTaskItemID(r.PathValue("item_id"))
 	if err != nil {
 		errs = append(errs, err)
 	}
 
-	attachments, err := ParseTaskAttachments(r)
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &TaskItemRequest{taskID, id}, nil
+}
+
+func ParseNewTaskItemRequest(r *http.Request) (*NewTaskItemRequest, error) {
+	var errs []error
+
+	taskID, err := ParseTaskID(r.PathValue("id"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	name, err := ParseTaskItemName(r.FormValue("name"))
 	if err != nil {
 		errs = append(errs, err)
 	}
@@ -217,32 +585,38 @@ func ParseNewTaskRequest(r *http.Request) (*NewTaskRequest, error) {
 		return nil, err
 	}
 
-	return &NewTaskRequest{name, expiresAt, attachments}, nil
+	return &NewTaskItemRequest{taskID, name}, nil
 }
 
-func ParseUpdateTaskRequest(r *http.Request) (*UpdateTaskRequest, error) {
-	if err := r.ParseMultipartForm(1 << 27); err != nil {
-		return nil, errors.New("attachments: payload size exceeds limit")
-	}
-
+func ParseTaskCommentRequest(r *http.Request) (*TaskCommentRequest, error) {
 	var errs []error
 
-	id, err := ParseTaskID(r.PathValue("id"))
+	taskID, err := ParseTaskID(r.PathValue("id"))
 	if err != nil {
 		errs = append(errs, err)
 	}
 
-	name, err := ParseTaskName(r.FormValue("name"))
+	id, err := ParseTaskCommentID(r.PathValue("comment_id"))
 	if err != nil {
 		errs = append(errs, err)
 	}
 
-	expiresAt, err := ParseTaskExpiresAt(r.FormValue("expires_at"), GetLocation(r))
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &TaskCommentRequest{taskID, id}, nil
+}
+
+func ParseNewTaskCommentRequest(r *http.Request) (*NewTaskCommentRequest, error) {
+	var errs []error
+
+	taskID, err := ParseTaskID(r.PathValue("id"))
 	if err != nil {
 		errs = append(errs, err)
 	}
 
-	attachments, err := ParseTaskAttachments(r)
+	body, err := ParseTaskCommentBody(r.FormValue("body"))
 	if err != nil {
 		errs = append(errs, err)
 	}
@@ -251,89 +625,1019 @@ func ParseUpdateTaskRequest(r *http.Request) (*UpdateTaskRequest, error) {
 		return nil, err
 	}
 
-	return &UpdateTaskRequest{id, name, expiresAt, attachments}, nil
+	return &NewTaskCommentRequest{taskID, body}, nil
 }
 
-func ParseLanguage(value string) (string, error) {
-	if !IsValidLanguage(value) {
-		return "", fmt.Errorf("language: required, supported values: %s", strings.Join(SupportedLanguages, ", "))
-	}
+func ParseUpdateTaskCommentRequest(r *http.Request) (*UpdateTaskCommentRequest, error) {
+	var errs []error
 
-	return value, nil
-}
+	taskID, err := ParseTaskID(r.PathValue("id"))
+	if err != nil {
+		errs = append(errs, err)
+	}
 
-func ParseTheme(value string) (string, error) {
-	if !IsValidTheme(value) {
-		return "", fmt.Errorf("theme: required, supported values: %s", strings.Join(SupportedThemes, ", "))
+	id, err := ParseTaskCommentID(r.PathValue("comment_id"))
+	if err != nil {
+		errs = append(errs, err)
 	}
 
-	return value, nil
-}
+	body, err := ParseTaskCommentBody(r.FormValue("body"))
+	if err != nil {
+		errs = append(errs, err)
+	}
 
-func ParseTimezone(value string) (string, error) {
-	if !IsValidTimezone(value) {
-		return "", fmt.Errorf("timezone: required, supported values: %s", strings.Join(SupportedTimezones, ", "))
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
 	}
 
-	return value, nil
+	return &UpdateTaskCommentRequest{taskID, id, body}, nil
 }
 
-func ParseTaskID(value string) (int, error) {
-	v, err := strconv.Atoi(value)
-	if err != nil || v < 1 {
-		return 0, errors.New("id: required, must be an integer greater than 0")
+func ParseUpdateTaskItemRequest(r *http.Request) (*UpdateTaskItemRequest, error) {
+	var errs []error
+
+	taskID, err := ParseTaskID(r.PathValue("id"))
+	if err != nil {
+		errs = append(errs, err)
 	}
 
-	return v, nil
-}
+	id, err := ParseTaskItemID(r.PathValue("item_id"))
+	if err != nil {
+		errs = append(errs, err)
+	}
 
-func ParseTaskAttachmentName(value string) (string, error) {
-	l := len(value)
-	if l < 1 || 200 < l {
-		return "", errors.New("name: required, must be between 1 and 200 characters")
+	name, err := ParseTaskItemName(r.FormValue("name"))
+	if err != nil {
+		errs = append(errs, err)
 	}
 
-	return value, nil
-}
+	completed, err := ParseTaskItemCompleted(r.FormValue("completed"))
+	if err != nil {
+		errs = append(errs, err)
+	}
 
-func ParseTaskName(value string) (string, error) {
-	l := len(value)
-	if l < 1 || 200 < l {
-		return "", errors.New("name: required, must be between 1 and 200 characters")
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
 	}
 
-	return value, nil
+	return &UpdateTaskItemRequest{taskID, id, name, completed}, nil
 }
 
-func ParseTaskExpiresAt(value string, l *time.Location) (*time.Time, error) {
-	if value == "" || l == nil {
-		return nil, nil
+func ParseMoveTaskItemRequest(r *http.Request) (*MoveTaskItemRequest, error) {
+	var errs []error
+
+	taskID, err := ParseTaskID(r.PathValue("id"))
+	if err != nil {
+		errs = append(errs, err)
 	}
 
-	v, err := ParseTime(value, l)
+	id, err := ParseTaskItemID(r.PathValue("item_id"))
 	if err != nil {
-		return nil, errors.New("expires_at: must be in ISO 8601 format")
+		errs = append(errs, err)
 	}
 
-	return v, nil
+	direction, err := ParseTaskItemDirection(r.FormValue("direction"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &MoveTaskItemRequest{taskID, id, direction}, nil
 }
 
-func ParseTaskAttachments(r *http.Request) (*AttachmentsRequest, error) {
-	files := r.MultipartForm.File["attachments"]
+func ParseTaskBlockerRequest(r *http.Request) (*TaskBlockerRequest, error) {
+	return parseTaskBlockerRequest(r, r.PathValue("blocker_id"))
+}
 
-	names := slices.DeleteFunc(
-		r.Form["attachments"],
-		func(name string) bool {
-			return name == ""
-		},
-	)
+func ParseNewTaskBlockerRequest(r *http.Request) (*TaskBlockerRequest, error) {
+	return parseTaskBlockerRequest(r, r.FormValue("blocker_id"))
+}
 
-	for _, file := range files {
-		names = append(names, file.Filename)
-	}
+func parseTaskBlockerRequest(r *http.Request, blockerID string) (*TaskBlockerRequest, error) {
+	var errs []error
 
-	slices.Sort(names)
-	names = slices.Compact(names)
+	taskID, err := ParseTaskID(r.PathValue("id"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	id, err := ParseTaskBlockerID(blockerID)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &TaskBlockerRequest{taskID, id}, nil
+}
+
+func ParseTaskMemberRequest(r *http.Request) (*TaskMemberRequest, error) {
+	var errs []error
+
+	taskID, err := ParseTaskID(r.PathValue("id"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	id, err := ParseTaskMemberID(r.PathValue("member_id"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &TaskMemberRequest{taskID, id}, nil
+}
+
+func ParseNewTaskMemberRequest(r *http.Request) (*NewTaskMemberRequest, error) {
+	var errs []error
+
+	taskID, err := ParseTaskID(r.PathValue("id"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	userID, email, err := ParseTaskMember(r.FormValue("member"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	role, err := ParseTaskMemberRole(r.FormValue("role"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &NewTaskMemberRequest{taskID, userID, email, role}, nil
+}
+
+// parseMultipartForm parses a form with attachments. A body that was cut off
+// by AttachmentLimits.LimitRequest is reported as *http.MaxBytesError.
+func parseMultipartForm(r *http.Request) error {
+	err := r.ParseMultipartForm(1 << 27)
+	if err == nil {
+		return nil
+	}
+
+	var maxErr *http.MaxBytesError
+	if errors.As(err, &maxErr) {
+		return maxErr
+	}
+
+	return errors.New("attachments: payload size exceeds limit")
+}
+
+func ParseNewTaskRequest(r *http.Request) (*NewTaskRequest, error) {
+	if err := parseMultipartForm(r); err != nil {
+		return nil, err
+	}
+
+	var errs []error
+
+	name, err := ParseTaskName(r.FormValue("name"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	description, err := ParseTaskDescription(r.FormValue("description"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	priority, err := ParseTaskPriority(r.FormValue("priority"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	tags, err := ParseTaskTags(r.Form["tags"])
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	expiresAt, err := ParseTaskExpiresAt(r.FormValue("expires_at"), GetLocation(r))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	recurrence, err := ParseTaskRecurrence(r.FormValue("recurrence"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	autoComplete, err := ParseTaskAutoComplete(r.FormValue("auto_complete"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	attachments, err := ParseTaskAttachments(r)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	projectID, err := ParseTaskProject(r.FormValue("project"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	assignee, err := ParseTaskAssignee(r.FormValue("assignee"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &NewTaskRequest{name, description, priority, tags, expiresAt, recurrence, autoComplete, attachments, projectID, assignee}, nil
+}
+
+func ParseUpdateTaskRequest(r *http.Request) (*UpdateTaskRequest, error) {
+	if err := parseMultipartForm(r); err != nil {
+		return nil, err
+	}
+
+	var errs []error
+
+	id, err := ParseTaskID(r.PathValue("id"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	name, err := ParseTaskName(r.FormValue("name"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	description, err := ParseTaskDescription(r.FormValue("description"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	priority, err := ParseTaskPriority(r.FormValue("priority"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	tags, err := ParseTaskTags(r.Form["tags"])
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	expiresAt, err := ParseTaskExpiresAt(r.FormValue("expires_at"), GetLocation(r))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	recurrence, err := ParseTaskRecurrence(r.FormValue("recurrence"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	autoComplete, err := ParseTaskAutoComplete(r.FormValue("auto_complete"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	attachments, err := ParseTaskAttachments(r)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	version, err := ParseTaskVersion(r.FormValue("version"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	// Only the owner of the task sees the project field. Without it the task
+	// stays in its project.
+	_, moveProject := r.Form["project"]
+
+	projectID, err := ParseTaskProject(r.FormValue("project"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	// Only the owner of the task sees the assignee field. Without it the
+	// assignee is kept.
+	var assignee *AssigneeRequest
+
+	if _, ok := r.Form["assignee"]; ok {
+		if assignee, err = ParseTaskAssignee(r.FormValue("assignee")); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &UpdateTaskRequest{id, version, name, description, priority, tags, expiresAt, recurrence, autoComplete, attachments, moveProject, projectID, assignee}, nil
+}
+
+func ParseAPITokenRequest(r *http.Request) (*APITokenRequest, error) {
+	var errs []error
+
+	id, err := ParseAPITokenID(r.PathValue("id"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &APITokenRequest{id}, nil
+}
+
+func ParseNewAPITokenRequest(r *http.Request) (*NewAPITokenRequest, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+
+	var errs []error
+
+	name, err := ParseAPITokenName(r.FormValue("name"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	scopes, err := ParseAPITokenScopes(r.Form["scopes"])
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	expiresAt, err := ParseAPITokenExpiresIn(r.FormValue("expires_in"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &NewAPITokenRequest{name, scopes, expiresAt}, nil
+}
+
+func ParseProjectRequest(r *http.Request) (*ProjectRequest, error) {
+	var errs []error
+
+	id, err := ParseProjectID(r.PathValue("id"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &ProjectRequest{id}, nil
+}
+
+func ParseSetProjectRequest(r *http.Request) (*SetProjectRequest, error) {
+	var errs []error
+
+	projectID, err := ParseTaskProjectFilter(r.FormValue("project"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &SetProjectRequest{projectID}, nil
+}
+
+func ParseNewProjectRequest(r *http.Request) (*NewProjectRequest, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+
+	var errs []error
+
+	name, err := ParseProjectName(r.FormValue("name"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	expirationDays, err := ParseProjectExpirationDays(r.FormValue("expiration_days"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &NewProjectRequest{name, expirationDays}, nil
+}
+
+func ParseUpdateProjectRequest(r *http.Request) (*UpdateProjectRequest, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+
+	var errs []error
+
+	id, err := ParseProjectID(r.PathValue("id"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	name, err := ParseProjectName(r.FormValue("name"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	expirationDays, err := ParseProjectExpirationDays(r.FormValue("expiration_days"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &UpdateProjectRequest{id, name, expirationDays}, nil
+}
+
+func ParseLanguage(value string) (string, error) {
+	if !IsValidLanguage(value) {
+		return "", fmt.Errorf("language: required, supported values: %s", strings.Join(SupportedLanguages, ", "))
+	}
+
+	return value, nil
+}
+
+func ParseTheme(value string) (string, error) {
+	if !IsValidTheme(value) {
+		return "", fmt.Errorf("theme: required, supported values: %s", strings.Join(SupportedThemes, ", "))
+	}
+
+	return value, nil
+}
+
+func ParseTimezone(value string) (string, error) {
+	if !IsValidTimezone(value) {
+		return "", fmt.Errorf("timezone: required, supported values: %s", strings.Join(SupportedTimezones, ", "))
+	}
+
+	return value, nil
+}
+
+func ParseSort(value string, supported []shared.TaskSort) (shared.TaskSort, error) {
+	if value == "" {
+		return supported[0], nil
+	}
+
+	sort := shared.TaskSort(value)
+	if !IsValidSort(sort, supported) {
+		var values []string
+		for _, s := range supported {
+			values = append(values, string(s))
+		}
+		return "", fmt.Errorf("sort: supported values: %s", strings.Join(values, ", "))
+	}
+
+	return sort, nil
+}
+
+func ParseSearchQuery(value string) (string, error) {
+	value = strings.TrimSpace(value)
+
+	if 200 < len(value) {
+		return "", errors.New("q: must be at most 200 characters")
+	}
+
+	return value, nil
+}
+
+func ParseTaskID(value string) (int, error) {
+	v, err := strconv.Atoi(value)
+	if err != nil || v < 1 {
+		return 0, errors.New("id: required, must be an integer greater than 0")
+	}
+
+	return v, nil
+}
+
+func ParseBulkTasksRequest(r *http.Request) (*BulkTasksRequest, error) {
+	var errs []error
+
+	action, err := ParseBulkAction(r.PathValue("action"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	filter, err := ParseTaskFilter(r.FormValue("filter"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	ids, err := ParseTaskIDs(r.Form["ids"])
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	var days int
+	if action == BulkActionReschedule {
+		if days, err = ParseBulkDays(r.FormValue("days")); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	var tag string
+	if action == BulkActionTag {
+		if tag, err = ParseTaskTag(r.FormValue("add_tag")); err != nil {
+			errs = append(errs, err)
+		} else if tag == "" {
+			errs = append(errs, errors.New("add_tag: required"))
+		}
+	}
+
+	var projectID *int
+	if action == BulkActionMove {
+		if projectID, err = ParseTaskProject(r.FormValue("move_project")); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &BulkTasksRequest{action, ids, filter, days, tag, projectID}, nil
+}
+
+func ParseBulkAction(value string) (string, error) {
+	if !slices.Contains(SupportedBulkActions, value) {
+		return "", fmt.Errorf("action: supported values: %s", strings.Join(SupportedBulkActions, ", "))
+	}
+
+	return value, nil
+}
+
+func ParseTaskIDs(values []string) ([]int, error) {
+	if len(values) == 0 {
+		return nil, errors.New("ids: required")
+	}
+
+	if BulkTasksMax < len(values) {
+		return nil, fmt.Errorf("ids: must be at most %d tasks", BulkTasksMax)
+	}
+
+	ids := make([]int, len(values))
+	for i, value := range values {
+		id, err := strconv.Atoi(value)
+		if err != nil || id < 1 {
+			return nil, errors.New("ids: must be integers greater than 0")
+		}
+		ids[i] = id
+	}
+
+	return ids, nil
+}
+
+func ParseBulkDays(value string) (int, error) {
+	v, err := strconv.Atoi(value)
+	if err != nil || v == 0 || v < -365 || 365 < v {
+		return 0, errors.New("days: required, must be a non-zero integer between -365 and 365")
+	}
+
+	return v, nil
+}
+
+func ParseTaskVersion(value string) (int, error) {
+	v, err := strconv.Atoi(value)
+	if err != nil || v < 1 {
+		return 0, errors.New("version: required, must be an integer greater than 0")
+	}
+
+	return v, nil
+}
+
+func ParseTaskItemID(value string) (int, error) {
+	v, err := strconv.Atoi(value)
+	if err != nil || v < 1 {
+		return 0, errors.New("item_id: required, must be an integer greater than 0")
+	}
+
+	return v, nil
+}
+
+func ParseTaskBlockerID(value string) (int, error) {
+	v, err := strconv.Atoi(value)
+	if err != nil || v < 1 {
+		return 0, errors.New("blocker_id: required, must be an integer greater than 0")
+	}
+
+	return v, nil
+}
+
+func ParseTaskCommentID(value string) (int, error) {
+	v, err := strconv.Atoi(value)
+	if err != nil || v < 1 {
+		return 0, errors.New("comment_id: required, must be an integer greater than 0")
+	}
+
+	return v, nil
+}
+
+func ParseTaskCommentBody(value string) (string, error) {
+	value = strings.TrimSpace(value)
+
+	l := len(value)
+	if l < 1 || 10000 < l {
+		return "", errors.New("body: required, must be between 1 and 10000 characters")
+	}
+
+	return value, nil
+}
+
+func ParseTaskItemName(value string) (string, error) {
+	value = strings.TrimSpace(value)
+
+	l := len(value)
+	if l < 1 || 200 < l {
+		return "", errors.New("name: required, must be between 1 and 200 characters")
+	}
+
+	return value, nil
+}
+
+func ParseTaskItemCompleted(value string) (bool, error) {
+	if value == "" {
+		return false, nil
+	}
+
+	v, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.New("completed: must be a boolean")
+	}
+
+	return v, nil
+}
+
+func ParseTaskItemDirection(value string) (string, error) {
+	if value != "up" && value != "down" {
+		return "", errors.New("direction: required, supported values: up, down")
+	}
+
+	return value, nil
+}
+
+func ParseTaskMemberID(value string) (int, error) {
+	v, err := strconv.Atoi(value)
+	if err != nil || v < 1 {
+		return 0, errors.New("member_id: required, must be an integer greater than 0")
+	}
+
+	return v, nil
+}
+
+// ParseTaskMember parses a user ID or an email address. Values containing
+// an @ are treated as email addresses.
+func ParseTaskMember(value string) (string, string, error) {
+	return parseTaskUser("member", value)
+}
+
+// ParseTaskAssignee parses the assignee of a task. An empty value means the
+// task is unassigned.
+func ParseTaskAssignee(value string) (*AssigneeRequest, error) {
+	if strings.TrimSpace(value) == "" {
+		return &AssigneeRequest{}, nil
+	}
+
+	userID, email, err := parseTaskUser("assignee", value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AssigneeRequest{userID, email}, nil
+}
+
+// parseTaskUser parses a user given either as a user ID or as an email
+// address, and returns the one that was given.
+func parseTaskUser(field string, value string) (string, string, error) {
+	value = strings.TrimSpace(value)
+
+	l := len(value)
+	if l < 1 || 200 < l || strings.ContainsFunc(value, unicode.IsSpace) {
+		return "", "", fmt.Errorf("%s: required, must be a user ID or an email address of at most 200 characters", field)
+	}
+
+	if !strings.Contains(value, "@") {
+		return value, "", nil
+	}
+
+	if a, err := mail.ParseAddress(value); err != nil || a.Address != value {
+		return "", "", fmt.Errorf("%s: must be a valid email address", field)
+	}
+
+	return "", strings.ToLower(value), nil
+}
+
+func ParseTaskMemberRole(value string) (shared.TaskRole, error) {
+	role := shared.TaskRole(value)
+
+	if !slices.Contains(shared.SupportedTaskMemberRoles, role) {
+		return "", errors.New("role: required, supported values: viewer, editor")
+	}
+
+	return role, nil
+}
+
+func ParseProjectID(value string) (int, error) {
+	v, err := strconv.Atoi(value)
+	if err != nil || v < 1 {
+		return 0, errors.New("id: required, must be an integer greater than 0")
+	}
+
+	return v, nil
+}
+
+func ParseProjectName(value string) (string, error) {
+	value = strings.TrimSpace(value)
+
+	l := len(value)
+	if l < 1 || 100 < l {
+		return "", errors.New("name: required, must be between 1 and 100 characters")
+	}
+
+	return value, nil
+}
+
+func ParseProjectExpirationDays(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	v, err := strconv.Atoi(value)
+	if err != nil || v < 0 || shared.MaxProjectExpirationDays < v {
+		return 0, fmt.Errorf("expiration_days: must be an integer between 0 and %d", shared.MaxProjectExpirationDays)
+	}
+
+	return v, nil
+}
+
+// ParseTaskProject parses the project of a task. An empty value means no
+// project.
+func ParseTaskProject(value string) (*int, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	v, err := strconv.Atoi(value)
+	if err != nil || v < 1 {
+		return nil, errors.New("project: must be an integer greater than 0")
+	}
+
+	return &v, nil
+}
+
+// ParseTaskProjectFilter parses the project of a task list. An empty value
+// means all projects.
+func ParseTaskProjectFilter(value string) (int, error) {
+	projectID, err := ParseTaskProject(value)
+	if err != nil || projectID == nil {
+		return 0, err
+	}
+
+	return *projectID, nil
+}
+
+func ParseAPITokenID(value string) (int, error) {
+	v, err := strconv.Atoi(value)
+	if err != nil || v < 1 {
+		return 0, errors.New("id: required, must be an integer greater than 0")
+	}
+
+	return v, nil
+}
+
+func ParseAPITokenName(value string) (string, error) {
+	l := len(value)
+	if l < 1 || 200 < l {
+		return "", errors.New("name: required, must be between 1 and 200 characters")
+	}
+
+	return value, nil
+}
+
+func ParseAPITokenScopes(values []string) ([]string, error) {
+	if len(values) == 0 {
+		return nil, fmt.Errorf("scopes: required, supported values: %s", strings.Join(shared.SupportedScopes, ", "))
+	}
+
+	for _, v := range values {
+		if !shared.IsValidScope(v) {
+			return nil, fmt.Errorf("scopes: supported values: %s", strings.Join(shared.SupportedScopes, ", "))
+		}
+	}
+
+	scopes := slices.Clone(values)
+	slices.Sort(scopes)
+
+	return slices.Compact(scopes), nil
+}
+
+func ParseAPITokenExpiresIn(value string) (*time.Time, error) {
+	if value == "" || value == "0" {
+		return nil, nil
+	}
+
+	v, err := strconv.Atoi(value)
+	if err != nil || !slices.Contains(SupportedAPITokenExpiresIn, v) {
+		return nil, errors.New("expires_in: must be one of the supported day counts")
+	}
+
+	t := shared.UTCNow().AddDate(0, 0, v)
+
+	return &t, nil
+}
+
+// ParseTaskAttachmentName validates an attachment name and returns it in
+// Unicode normalization form C without surrounding white space. Names that
+// could be interpreted as paths are rejected, so that they are safe to use as
+// download file names.
+func ParseTaskAttachmentName(value string) (string, error) {
+	if !utf8.ValidString(value) {
+		return "", errors.New("name: must be valid UTF-8")
+	}
+
+	value = strings.TrimSpace(norm.NFC.String(value))
+
+	l := utf8.RuneCountInString(value)
+	if l < 1 || 200 < l {
+		return "", errors.New("name: required, must be between 1 and 200 characters")
+	}
+
+	if value == "." || value == ".." || strings.ContainsAny(value, `/\`) {
+		return "", errors.New(`name: must not contain path separators or be "." or ".."`)
+	}
+
+	if strings.ContainsFunc(value, unicode.IsControl) {
+		return "", errors.New("name: must not contain control characters")
+	}
+
+	return value, nil
+}
+
+// ParseTaskAttachmentFiles validates the names of uploaded files and replaces
+// them with their normalized form.
+func ParseTaskAttachmentFiles(files []*multipart.FileHeader) error {
+	var errs []error
+
+	for _, file := range files {
+		name, err := ParseTaskAttachmentName(file.Filename)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		file.Filename = name
+	}
+
+	return errors.Join(errs...)
+}
+
+func ParseTaskName(value string) (string, error) {
+	l := len(value)
+	if l < 1 || 200 < l {
+		return "", errors.New("name: required, must be between 1 and 200 characters")
+	}
+
+	return value, nil
+}
+
+func ParseTaskDescription(value string) (string, error) {
+	if 10_000 < len(value) {
+		return "", errors.New("description: must be at most 10000 characters")
+	}
+
+	return value, nil
+}
+
+func ParseTaskPriority(value string) (shared.Priority, error) {
+	if value == "" {
+		return shared.PriorityNormal, nil
+	}
+
+	priority, err := shared.ParsePriority(value)
+	if err != nil {
+		var values []string
+		for _, p := range shared.SupportedPriorities {
+			values = append(values, p.String())
+		}
+		return 0, fmt.Errorf("priority: supported values: %s", strings.Join(values, ", "))
+	}
+
+	return priority, nil
+}
+
+func ParseTaskTag(value string) (string, error) {
+	value = strings.TrimSpace(value)
+
+	if 50 < len(value) {
+		return "", errors.New("tag: must be at most 50 characters")
+	}
+
+	return value, nil
+}
+
+func ParseTaskTags(values []string) ([]string, error) {
+	var tags []string
+
+	for _, value := range values {
+		for name := range strings.SplitSeq(value, ",") {
+			name = strings.TrimSpace(name)
+			if name == "" {
+				continue
+			}
+
+			if 50 < len(name) {
+				return nil, errors.New("tags: each tag must be at most 50 characters")
+			}
+
+			tags = append(tags, name)
+		}
+	}
+
+	slices.Sort(tags)
+	tags = slices.Compact(tags)
+
+	if 20 < len(tags) {
+		return nil, errors.New("tags: must be at most 20 tags")
+	}
+
+	return tags, nil
+}
+
+func ParseTaskExpiresAt(value string, l *time.Location) (*time.Time, error) {
+	if value == "" || l == nil {
+		return nil, nil
+	}
+
+	v, err := ParseTime(value, l)
+	if err != nil {
+		return nil, errors.New("expires_at: must be in ISO 8601 format")
+	}
+
+	return v, nil
+}
+
+func ParseTaskRecurrence(value string) (string, error) {
+	if 500 < len(value) {
+		return "", errors.New("recurrence: must be at most 500 characters")
+	}
+
+	rule, err := shared.NormalizeRecurrence(value)
+	if err != nil {
+		return "", fmt.Errorf("recurrence: must be a valid RRULE: %w", err)
+	}
+
+	return rule, nil
+}
+
+func ParseTaskAutoComplete(value string) (bool, error) {
+	if value == "" {
+		return false, nil
+	}
+
+	if value == "on" {
+		return true, nil
+	}
+
+	v, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.New("auto_complete: must be a boolean")
+	}
+
+	return v, nil
+}
+
+func ParseTaskAttachments(r *http.Request) (*AttachmentsRequest, error) {
+	files := r.MultipartForm.File["attachments"]
+	if err := ParseTaskAttachmentFiles(files); err != nil {
+		return nil, err
+	}
+
+	names := slices.DeleteFunc(
+		r.Form["attachments"],
+		func(name string) bool {
+			return name == ""
+		},
+	)
 
 	return &AttachmentsRequest{names, files}, nil
 }
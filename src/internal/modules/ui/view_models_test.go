@@ -0,0 +1,69 @@
+package ui
+
+import (
+	"slices"
+	"tasks-app/internal/shared"
+	"testing"
+)
+
+func TestParseAPITokenScopes(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []string
+		want    []string
+		wantErr bool
+	}{
+		{name: "read", values: []string{shared.ScopeTasksRead}, want: []string{shared.ScopeTasksRead}},
+		{name: "write", values: []string{shared.ScopeTasksWrite}, want: []string{shared.ScopeTasksWrite}},
+		{name: "sorted", values: []string{shared.ScopeTasksWrite, shared.ScopeTasksRead}, want: []string{shared.ScopeTasksRead, shared.ScopeTasksWrite}},
+		{name: "duplicates", values: []string{shared.ScopeTasksRead, shared.ScopeTasksRead}, want: []string{shared.ScopeTasksRead}},
+		{name: "none", values: nil, wantErr: true},
+		{name: "empty", values: []string{""}, wantErr: true},
+		{name: "unknown", values: []string{shared.ScopeTasksRead, "tasks:admin"}, wantErr: true},
+		{name: "case sensitive", values: []string{"TASKS:READ"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAPITokenScopes(tt.values)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseAPITokenScopes(%q) error = %v, wantErr %v", tt.values, err, tt.wantErr)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ParseAPITokenScopes(%q) = %q, want %q", tt.values, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTaskAttachmentName(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{name: "plain", value: "report.pdf", want: "report.pdf"},
+		{name: "trimmed", value: "  report.pdf ", want: "report.pdf"},
+		{name: "normalized", value: "kesa\u0308.txt", want: "kes\u00e4.txt"},
+		{name: "empty", value: " ", wantErr: true},
+		{name: "current directory", value: ".", wantErr: true},
+		{name: "parent directory", value: "..", wantErr: true},
+		{name: "slash", value: "a/b.txt", wantErr: true},
+		{name: "backslash", value: `a\b.txt`, wantErr: true},
+		{name: "control character", value: "a\nb.txt", wantErr: true},
+		{name: "invalid utf-8", value: "a\xffb.txt", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTaskAttachmentName(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseTaskAttachmentName(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseTaskAttachmentName(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -7,6 +7,9 @@ import { wsconnect } from '@nats-io/nats-core';
 
 _hyperscript.browserInit();
 
+// A conflict response carries a partial that replaces the edited content.
+htmx.config.responseHandling = [{ code: '409', swap: true }, ...htmx.config.responseHandling];
+
 window.app = Object.assign({}, window.app, {
 	showConfirmModal,
 	showToastMessage
@@ -79,6 +82,10 @@ function handleMsg(msg) {
 			handleTaskExpiringMsg(msg);
 		} else if (msg.subject.endsWith('.expired')) {
 			handleTaskExpiredMsg(msg);
+		} else if (msg.subject.endsWith('.commented')) {
+			handleTaskCommentedMsg(msg);
+		} else if (msg.subject.endsWith('.unblocked')) {
+			handleTaskUnblockedMsg(msg);
 		} else {
 			handleUnknownMsg(msg);
 		}
@@ -105,6 +112,29 @@ function handleTaskExpiredMsg(msg) {
 	});
 }
 
+function handleTaskCommentedMsg(msg) {
+	const data = msg.json();
+
+	const commentsEl = document.getElementById(`task-comments-${data?.task?.id}`);
+	if (commentsEl) htmx.trigger(commentsEl, 'taskCommented');
+
+	showToastMessage({
+		type: 'info',
+		title: 'New Comment',
+		text: `${data?.comment?.user_name ?? ''}: ${data?.task?.name ?? '<no name>'}`,
+		details: data?.comment?.body ?? ''
+	});
+}
+
+function handleTaskUnblockedMsg(msg) {
+	const data = msg.json();
+	showToastMessage({
+		type: 'info',
+		title: 'Task Unblocked',
+		text: data?.task?.name ?? '<no name>'
+	});
+}
+
 function handleUnknownMsg(msg) {
 	console.log('dropped unknown message', msg.subject);
 }
@@ -0,0 +1,158 @@
+package ui
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"tasks-app/internal/shared"
+	"time"
+)
+
+// zitadelTokenCacheSweepSize is the number of cached tokens above which
+// expired tokens are removed from the cache.
+const zitadelTokenCacheSweepSize = 1000
+
+// ZitadelTokenResolver resolves bearer tokens with the userinfo endpoint of
+// ZITADEL. Resolved users are cached by the hash of the token for
+// APP_UI_AUTH_TOKEN_CACHE_TTL, so a revoked token stays valid for at most
+// that long.
+type ZitadelTokenResolver struct {
+	Config *shared.Config
+	client *http.Client
+	mu     sync.Mutex
+	cache  map[string]zitadelCachedUser
+}
+
+type zitadelCachedUser struct {
+	user      *shared.UserContext
+	expiresAt time.Time
+}
+
+var _ TokenResolver = (*ZitadelTokenResolver)(nil)
+
+func NewZitadelTokenResolver(config *shared.Config) *ZitadelTokenResolver {
+	return &ZitadelTokenResolver{
+		Config: config,
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: config.UI.AuthInsecureSkipVerify,
+				},
+			},
+		},
+		cache: make(map[string]zitadelCachedUser),
+	}
+}
+
+func (r *ZitadelTokenResolver) ResolveToken(ctx context.Context, token string) (*shared.UserContext, error) {
+	key := shared.HashAPIToken(token)
+
+	if user := r.getCached(key); user != nil {
+		return user, nil
+	}
+
+	user, err := r.resolveUserInfo(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	r.putCached(key, user)
+
+	return user, nil
+}
+
+// getCached returns a copy of the cached user, so that requests cannot change
+// each other's user context.
+func (r *ZitadelTokenResolver) getCached(key string) *shared.UserContext {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cached, ok := r.cache[key]
+	if !ok || !time.Now().Before(cached.expiresAt) {
+		return nil
+	}
+
+	user := *cached.user
+	return &user
+}
+
+func (r *ZitadelTokenResolver) putCached(key string, user *shared.UserContext) {
+	ttl := r.Config.UI.AuthTokenCacheTTL
+	if ttl <= 0 {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+
+	if len(r.cache) >= zitadelTokenCacheSweepSize {
+		for k, cached := range r.cache {
+			if !now.Before(cached.expiresAt) {
+				delete(r.cache, k)
+			}
+		}
+	}
+
+	cached := *user
+	r.cache[key] = zitadelCachedUser{&cached, now.Add(ttl)}
+}
+
+func (r *ZitadelTokenResolver) resolveUserInfo(ctx context.Context, token string) (*shared.UserContext, error) {
+	url, err := url.JoinPath("https://"+r.Config.UI.AuthDomain, "/oidc/v1/userinfo")
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Accept", "application/json")
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	res, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusUnauthorized {
+		return nil, ErrInvalidToken
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("userinfo: unexpected status %d", res.StatusCode)
+	}
+
+	body := struct {
+		Subject       string `json:"sub"`
+		Name          string `json:"name"`
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+	}{}
+
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	if body.Subject == "" {
+		return nil, ErrInvalidToken
+	}
+
+	return &shared.UserContext{
+		ID:            body.Subject,
+		Name:          body.Name,
+		Email:         body.Email,
+		EmailVerified: body.EmailVerified,
+		AccessToken:   token,
+	}, nil
+}
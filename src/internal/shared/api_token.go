@@ -0,0 +1,116 @@
+package shared
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	ScopeTasksRead  = "tasks:read"
+	ScopeTasksWrite = "tasks:write"
+)
+
+const APITokenPrefix = "tapp_"
+
+// APITokenLastUsedInterval is how often the last use of a token is recorded,
+// so that API requests do not write to the database every time.
+const APITokenLastUsedInterval = time.Minute
+
+var SupportedScopes = []string{
+	ScopeTasksRead,
+	ScopeTasksWrite,
+}
+
+type Scopes []string
+
+type APIToken struct {
+	ID          int        `json:"id"`
+	UserID      string     `json:"user_id"`
+	UserEmail   string     `json:"user_email"`
+	Name        string     `json:"name"`
+	TokenPrefix string     `json:"token_prefix"`
+	TokenHash   string     `json:"-"`
+	Scopes      Scopes     `json:"scopes"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+	LastUsedAt  *time.Time `json:"last_used_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	RevokedAt   *time.Time `json:"revoked_at"`
+}
+
+// NewAPIToken creates a token and returns it together with its plaintext
+// value. Only the hash of the value is stored.
+func NewAPIToken(name string, scopes Scopes, expiresAt *time.Time) (*APIToken, string) {
+	now := UTCNow()
+
+	value := APITokenPrefix + rand.Text()
+
+	return &APIToken{
+		Name:        name,
+		TokenPrefix: value[:len(APITokenPrefix)+8],
+		TokenHash:   HashAPIToken(value),
+		Scopes:      scopes,
+		ExpiresAt:   expiresAt,
+		CreatedAt:   now,
+	}, value
+}
+
+func HashAPIToken(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+func IsAPIToken(value string) bool {
+	return strings.HasPrefix(value, APITokenPrefix)
+}
+
+func IsValidScope(scope string) bool {
+	return slices.Contains(SupportedScopes, scope)
+}
+
+func (t *APIToken) IsActive() bool {
+	if t.RevokedAt != nil {
+		return false
+	}
+
+	return t.ExpiresAt == nil || UTCNow().Before(*t.ExpiresAt)
+}
+
+// SetLastUsed records the use of the token and reports whether it has to be
+// saved. Uses within APITokenLastUsedInterval of the last recorded use are
+// not recorded.
+func (t *APIToken) SetLastUsed() bool {
+	now := UTCNow()
+
+	if t.LastUsedAt != nil && now.Sub(*t.LastUsedAt) < APITokenLastUsedInterval {
+		return false
+	}
+
+	t.LastUsedAt = &now
+	return true
+}
+
+func (t *APIToken) SetRevoked() {
+	now := UTCNow()
+
+	t.RevokedAt = &now
+}
+
+func (s Scopes) Value() (driver.Value, error) {
+	return strings.Join(s, " "), nil
+}
+
+func (s *Scopes) Scan(src any) error {
+	v, ok := src.(string)
+	if !ok {
+		return errors.New("type assertion to string")
+	}
+
+	*s = strings.Fields(v)
+	return nil
+}
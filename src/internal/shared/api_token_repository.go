@@ -0,0 +1,11 @@
+package shared
+
+import "context"
+
+type APITokenRepository interface {
+	Create(ctx context.Context, token *APIToken) error
+	Update(ctx context.Context, token *APIToken) error
+	GetByID(ctx context.Context, id int) (*APIToken, error)
+	GetByHash(ctx context.Context, hash string) (*APIToken, error)
+	GetAll(ctx context.Context) ([]*APIToken, error)
+}
@@ -0,0 +1,47 @@
+package shared
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAPITokenSetLastUsed(t *testing.T) {
+	now := UTCNow()
+
+	tests := []struct {
+		name       string
+		lastUsedAt *time.Time
+		want       bool
+	}{
+		{
+			name: "never used",
+			want: true,
+		},
+		{
+			name:       "used recently",
+			lastUsedAt: ptr(now.Add(-10 * time.Second)),
+			want:       false,
+		},
+		{
+			name:       "used before the interval",
+			lastUsedAt: ptr(now.Add(-APITokenLastUsedInterval - time.Second)),
+			want:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := &APIToken{LastUsedAt: tt.lastUsedAt}
+
+			if got := token.SetLastUsed(); got != tt.want {
+				t.Errorf("SetLastUsed() = %v, want %v", got, tt.want)
+			}
+			if tt.want && !token.LastUsedAt.After(now.Add(-time.Second)) {
+				t.Errorf("LastUsedAt = %v, want the current time", token.LastUsedAt)
+			}
+			if !tt.want && token.LastUsedAt != tt.lastUsedAt {
+				t.Errorf("LastUsedAt = %v, want %v", token.LastUsedAt, tt.lastUsedAt)
+			}
+		})
+	}
+}
@@ -8,31 +8,54 @@ import (
 )
 
 type SharedConfig struct {
-	Services                 []string `env:"APP_SHARED_SERVICES" envDefault:"db:postgres,attachments:nats,messaging:nats"`
-	Modules                  []string `env:"APP_SHARED_MODULES" envDefault:"ui,taskchecker,emailnotifier:smtp"`
-	LogLevel                 string   `env:"APP_SHARED_LOG_LEVEL" envDefault:"warn"`
-	PostgresConnectionString string   `env:"APP_SHARED_POSTGRES_CONNECTION_STRING,notEmpty"`
-	NATSURL                  string   `env:"APP_SHARED_NATS_URL,notEmpty"`
-	NATSCreds                string   `env:"APP_SHARED_NATS_CREDS,notEmpty"`
-	NATSAccountPublicKey     string   `env:"APP_SHARED_NATS_ACCOUNT_PUBLIC_KEY,notEmpty"`
-	NATSAccountSeed          string   `env:"APP_SHARED_NATS_ACCOUNT_SEED,notEmpty"`
-	AttachmentsPath          string   `env:"APP_SHARED_ATTACHMENTS_PATH" envDefault:"attachments"`
+	Services                 []string      `env:"APP_SHARED_SERVICES" envDefault:"db:postgres,attachments:nats,messaging:nats"`
+	Modules                  []string      `env:"APP_SHARED_MODULES" envDefault:"ui,taskchecker,outboxrelay,emailnotifier:smtp"`
+	LogLevel                 string        `env:"APP_SHARED_LOG_LEVEL" envDefault:"warn"`
+	PostgresConnectionString string        `env:"APP_SHARED_POSTGRES_CONNECTION_STRING,notEmpty"`
+	NATSURL                  string        `env:"APP_SHARED_NATS_URL,notEmpty"`
+	NATSCreds                string        `env:"APP_SHARED_NATS_CREDS,notEmpty"`
+	NATSAccountPublicKey     string        `env:"APP_SHARED_NATS_ACCOUNT_PUBLIC_KEY,notEmpty"`
+	NATSAccountSeed          string        `env:"APP_SHARED_NATS_ACCOUNT_SEED,notEmpty"`
+	AttachmentsPath          string        `env:"APP_SHARED_ATTACHMENTS_PATH" envDefault:"attachments"`
+	S3Endpoint               string        `env:"APP_SHARED_S3_ENDPOINT"`
+	S3Region                 string        `env:"APP_SHARED_S3_REGION"`
+	S3AccessKey              string        `env:"APP_SHARED_S3_ACCESS_KEY"`
+	S3SecretKey              string        `env:"APP_SHARED_S3_SECRET_KEY"`
+	S3UseSSL                 bool          `env:"APP_SHARED_S3_USE_SSL" envDefault:"true"`
+	S3Bucket                 string        `env:"APP_SHARED_S3_BUCKET" envDefault:"tasks-app"`
+	S3Prefix                 string        `env:"APP_SHARED_S3_PREFIX" envDefault:"attachments"`
+	S3PartSize               uint64        `env:"APP_SHARED_S3_PART_SIZE" envDefault:"16777216"`
+	LeaderLeaseTTL           time.Duration `env:"APP_SHARED_LEADER_LEASE_TTL,notEmpty" envDefault:"2m"`
+	LeaderReplicas           int           `env:"APP_SHARED_LEADER_REPLICAS" envDefault:"3"`
 }
 
 type UIConfig struct {
-	Addr              string   `env:"APP_UI_ADDR,notEmpty" envDefault:":8080"`
-	AuthDomain        string   `env:"APP_UI_AUTH_DOMAIN"`
-	AuthEncryptionKey string   `env:"APP_UI_AUTH_ENCRYPTION_KEY"`
-	AuthClientId      string   `env:"APP_UI_AUTH_CLIENT_ID"`
-	AuthRedirectURI   string   `env:"APP_UI_AUTH_REDIRECT_URI"`
-	NATSJWTCookieName string   `env:"APP_UI_NATS_JWT_COOKIE_NAME,notEmpty"`
-	TrustedHosts      []string `env:"APP_UI_TRUSTED_HOSTS"`
+	Addr                   string        `env:"APP_UI_ADDR,notEmpty" envDefault:":8080"`
+	AuthDomain             string        `env:"APP_UI_AUTH_DOMAIN"`
+	AuthEncryptionKey      string        `env:"APP_UI_AUTH_ENCRYPTION_KEY"`
+	AuthClientId           string        `env:"APP_UI_AUTH_CLIENT_ID"`
+	AuthRedirectURI        string        `env:"APP_UI_AUTH_REDIRECT_URI"`
+	AuthInsecureSkipVerify bool          `env:"APP_UI_AUTH_INSECURE_SKIP_VERIFY"`
+	AuthTokenCacheTTL      time.Duration `env:"APP_UI_AUTH_TOKEN_CACHE_TTL" envDefault:"60s"`
+	NATSJWTCookieName      string        `env:"APP_UI_NATS_JWT_COOKIE_NAME,notEmpty"`
+	TrustedHosts           []string      `env:"APP_UI_TRUSTED_HOSTS"`
+	AttachmentMaxFileSize  int64         `env:"APP_UI_ATTACHMENT_MAX_FILE_SIZE" envDefault:"33554432"`
+	AttachmentAllowedTypes []string      `env:"APP_UI_ATTACHMENT_ALLOWED_TYPES"`
+	AttachmentMaxTaskFiles int           `env:"APP_UI_ATTACHMENT_MAX_TASK_FILES" envDefault:"20"`
+	AttachmentUserQuota    int64         `env:"APP_UI_ATTACHMENT_USER_QUOTA" envDefault:"1073741824"`
 }
 
 type TaskCheckerConfig struct {
 	CheckInterval  time.Duration `env:"APP_TASK_CHECKER_CHECK_INTERVAL,notEmpty" envDefault:"60s"`
 	ExpiringWindow time.Duration `env:"APP_TASK_CHECKER_EXPIRING_WINDOW,notEmpty" envDefault:"24h"`
 	DeleteWindow   time.Duration `env:"APP_TASK_CHECKER_DELETE_WINDOW,notEmpty" envDefault:"48h"`
+	TrashWindow    time.Duration `env:"APP_TASK_CHECKER_TRASH_WINDOW,notEmpty" envDefault:"720h"`
+}
+
+type OutboxRelayConfig struct {
+	PollInterval time.Duration `env:"APP_OUTBOX_RELAY_POLL_INTERVAL,notEmpty" envDefault:"2s"`
+	BatchSize    int           `env:"APP_OUTBOX_RELAY_BATCH_SIZE,notEmpty" envDefault:"100"`
+	DeleteWindow time.Duration `env:"APP_OUTBOX_RELAY_DELETE_WINDOW,notEmpty" envDefault:"24h"`
 }
 
 type EmailNotifierConfig struct {
@@ -49,6 +72,7 @@ type Config struct {
 	Shared        SharedConfig
 	UI            UIConfig
 	TaskChecker   TaskCheckerConfig
+	OutboxRelay   OutboxRelayConfig
 	EmailNotifier EmailNotifierConfig
 }
 
@@ -3,3 +3,12 @@ package shared
 import "errors"
 
 var ErrNotFound = errors.New("not found")
+
+// ErrConflict is returned when an entity was modified after it was read.
+var ErrConflict = errors.New("conflict")
+
+// ErrForbidden is returned when the user may see an entity but not change it.
+var ErrForbidden = errors.New("forbidden")
+
+// ErrDuplicate is returned when an entity with the same unique name exists.
+var ErrDuplicate = errors.New("duplicate")
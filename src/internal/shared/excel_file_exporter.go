@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"strings"
 
 	"github.com/xuri/excelize/v2"
 )
@@ -34,7 +35,11 @@ func (e *ExcelFileExporter) ExportTasks(w http.ResponseWriter, tasks []*Task, na
 	if err := f.SetSheetRow("Tasks", "A1", &[]any{
 		"ID",
 		"Name",
+		"Description",
+		"Priority",
+		"Tags",
 		"Expires At",
+		"Recurrence",
 		"Expiring Info At",
 		"Expired Info At",
 		"Created At",
@@ -53,7 +58,11 @@ func (e *ExcelFileExporter) ExportTasks(w http.ResponseWriter, tasks []*Task, na
 		if err := f.SetSheetRow("Tasks", cell, &[]any{
 			task.ID,
 			task.Name,
+			task.Description,
+			task.Priority.String(),
+			strings.Join(task.Tags.Names(), ", "),
 			task.ExpiresAt,
+			task.Recurrence,
 			task.ExpiringInfoAt,
 			task.ExpiredInfoAt,
 			task.CreatedAt,
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"io"
 	"io/fs"
-	"mime/multipart"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -17,20 +16,31 @@ type FileTaskAttachmentsRepository struct {
 
 var _ TaskAttachmentsRepository = (*FileTaskAttachmentsRepository)(nil)
 
-func (repo *FileTaskAttachmentsRepository) GetAttachment(ctx context.Context, taskID int, name string) ([]byte, error) {
-	data, err := os.ReadFile(repo.getAttachmentPath(taskID, name))
+func (repo *FileTaskAttachmentsRepository) GetAttachment(ctx context.Context, taskID int, attachment *Attachment) (*AttachmentContent, error) {
+	path, err := repo.getAttachmentPath(taskID, attachment.StorageKey)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
 	if errors.Is(err, fs.ErrNotExist) {
-		return nil, nil
+		return nil, ErrNotFound
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	return data, nil
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return NewAttachmentContent(attachment, file, info.Size(), "", info.ModTime())
 }
 
-func (repo *FileTaskAttachmentsRepository) SaveAttachments(ctx context.Context, taskID int, fileHeaders []*multipart.FileHeader) error {
-	if len(fileHeaders) == 0 {
+func (repo *FileTaskAttachmentsRepository) SaveAttachments(ctx context.Context, taskID int, uploads []*AttachmentUpload) error {
+	if len(uploads) == 0 {
 		return nil
 	}
 
@@ -38,14 +48,19 @@ func (repo *FileTaskAttachmentsRepository) SaveAttachments(ctx context.Context,
 		return err
 	}
 
-	for _, fileHeader := range fileHeaders {
-		srcFile, err := fileHeader.Open()
+	for _, upload := range uploads {
+		path, err := repo.getAttachmentPath(taskID, upload.Attachment.StorageKey)
+		if err != nil {
+			return err
+		}
+
+		srcFile, err := upload.File.Open()
 		if err != nil {
 			return err
 		}
 		defer srcFile.Close()
 
-		dstFile, err := os.Create(repo.getAttachmentPath(taskID, fileHeader.Filename))
+		dstFile, err := os.Create(path)
 		if err != nil {
 			return err
 		}
@@ -60,13 +75,18 @@ func (repo *FileTaskAttachmentsRepository) SaveAttachments(ctx context.Context,
 	return nil
 }
 
-func (repo *FileTaskAttachmentsRepository) DeleteAttachments(ctx context.Context, taskID int, deleted map[int]string) error {
+func (repo *FileTaskAttachmentsRepository) DeleteAttachments(ctx context.Context, taskID int, deleted []*Attachment) error {
 	if len(deleted) == 0 {
 		return nil
 	}
 
-	for _, name := range deleted {
-		if err := os.Remove(repo.getAttachmentPath(taskID, name)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+	for _, a := range deleted {
+		path, err := repo.getAttachmentPath(taskID, a.StorageKey)
+		if err != nil {
+			return err
+		}
+
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
 			return err
 		}
 	}
@@ -82,8 +102,16 @@ func (repo *FileTaskAttachmentsRepository) ensureTaskDir(taskID int) error {
 	return os.MkdirAll(repo.getTaskPath(taskID), 0755)
 }
 
-func (repo *FileTaskAttachmentsRepository) getAttachmentPath(taskID int, name string) string {
-	return filepath.Join(repo.getTaskPath(taskID), name)
+// getAttachmentPath returns the path of an attachment in the directory of its
+// task. Storage keys are generated by the server, but keys of attachments
+// created before they were introduced are file names, so keys that could
+// point outside the directory, or at the directory itself, are rejected.
+func (repo *FileTaskAttachmentsRepository) getAttachmentPath(taskID int, storageKey string) (string, error) {
+	if !filepath.IsLocal(storageKey) || filepath.Base(storageKey) != storageKey || storageKey == "." {
+		return "", errors.New("invalid attachment storage key")
+	}
+
+	return filepath.Join(repo.getTaskPath(taskID), storageKey), nil
 }
 
 func (repo *FileTaskAttachmentsRepository) getTaskPath(taskID int) string {
@@ -0,0 +1,38 @@
+package shared
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestFileTaskAttachmentsRepositoryGetAttachmentPath(t *testing.T) {
+	repo := &FileTaskAttachmentsRepository{Config: &Config{Shared: SharedConfig{AttachmentsPath: "attachments"}}}
+
+	tests := []struct {
+		name       string
+		storageKey string
+		want       string
+		wantErr    bool
+	}{
+		{name: "generated key", storageKey: "K7QJ4XW2M5PZ3NTR", want: filepath.Join("attachments", "42", "K7QJ4XW2M5PZ3NTR")},
+		{name: "legacy file name", storageKey: "report 2026.pdf", want: filepath.Join("attachments", "42", "report 2026.pdf")},
+		{name: "empty", storageKey: "", wantErr: true},
+		{name: "current directory", storageKey: ".", wantErr: true},
+		{name: "parent directory", storageKey: "..", wantErr: true},
+		{name: "traversal", storageKey: "../41/secret.pdf", wantErr: true},
+		{name: "subdirectory", storageKey: "dir/file.pdf", wantErr: true},
+		{name: "absolute", storageKey: "/etc/passwd", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repo.getAttachmentPath(42, tt.storageKey)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getAttachmentPath(%q) error = %v, wantErr %v", tt.storageKey, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("getAttachmentPath(%q) = %q, want %q", tt.storageKey, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package shared
+
+import "context"
+
+// LeaderElector decides which app instance runs a singleton workload.
+// IsLeader acquires or renews the leadership and must be called more often
+// than the lease expires.
+type LeaderElector interface {
+	IsLeader(ctx context.Context, name string) (bool, error)
+	Resign(ctx context.Context, name string) error
+}
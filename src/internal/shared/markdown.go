@@ -0,0 +1,25 @@
+package shared
+
+import (
+	"bytes"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+var (
+	markdown       = goldmark.New(goldmark.WithExtensions(extension.GFM))
+	markdownPolicy = bluemonday.UGCPolicy()
+)
+
+// RenderMarkdown converts Markdown to HTML that is safe to embed in a page.
+func RenderMarkdown(src string) (string, error) {
+	var buf bytes.Buffer
+
+	if err := markdown.Convert([]byte(src), &buf); err != nil {
+		return "", err
+	}
+
+	return markdownPolicy.Sanitize(buf.String()), nil
+}
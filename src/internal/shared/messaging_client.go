@@ -16,6 +16,7 @@ type Message interface {
 type MessagingClient interface {
 	Send(ctx context.Context, subject string, data any) error
 	SendPersistent(ctx context.Context, subject string, data any) error
+	SendPersistentWithID(ctx context.Context, subject string, msgID string, data any) error
 	Subscribe(ctx context.Context, subject string, handler func(ctx context.Context, msg Message) error) error
 	SubscribePersistent(ctx context.Context, stream string, consumer string, handler func(ctx context.Context, msg Message) error) error
 }
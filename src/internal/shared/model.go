@@ -1,63 +1,181 @@
 package shared
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
 	"database/sql/driver"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
 	"time"
 )
 
 type Task struct {
-	ID             int         `json:"id"`
-	UserID         string      `json:"user_id"`
-	Name           string      `json:"name"`
-	ExpiresAt      *time.Time  `json:"expires_at"`
-	ExpiringInfoAt *time.Time  `json:"expiring_info_at"`
-	ExpiredInfoAt  *time.Time  `json:"expired_info_at"`
-	CreatedAt      time.Time   `json:"created_at"`
-	UpdatedAt      *time.Time  `json:"updated_at"`
-	CompletedAt    *time.Time  `json:"completed_at"`
-	Attachments    Attachments `json:"attachments"`
+	ID                 int          `json:"id"`
+	UserID             string       `json:"user_id"`
+	ProjectID          *int         `json:"project_id"`
+	AssigneeUserID     string       `json:"assignee_user_id"`
+	AssigneeEmail      string       `json:"assignee_email"`
+	Name               string       `json:"name"`
+	Description        string       `json:"description"`
+	Priority           Priority     `json:"priority"`
+	ExpiresAt          *time.Time   `json:"expires_at"`
+	Recurrence         string       `json:"recurrence"`
+	RecurrenceTimezone string       `json:"recurrence_timezone"`
+	RecurrenceStart    *time.Time   `json:"recurrence_start"`
+	RecurredAt         *time.Time   `json:"recurred_at"`
+	AutoComplete       bool         `json:"auto_complete"`
+	ExpiringInfoAt     *time.Time   `json:"expiring_info_at"`
+	ExpiredInfoAt      *time.Time   `json:"expired_info_at"`
+	CreatedAt          time.Time    `json:"created_at"`
+	UpdatedAt          *time.Time   `json:"updated_at"`
+	CompletedAt        *time.Time   `json:"completed_at"`
+	DeletedAt          *time.Time   `json:"deleted_at"`
+	Version            int          `json:"version"`
+	Attachments        Attachments  `json:"attachments"`
+	Tags               Tags         `json:"tags"`
+	Items              TaskItems    `json:"items"`
+	Members            TaskMembers  `json:"members"`
+	Blockers           TaskBlockers `json:"blockers"`
 }
 
 type Attachments []*Attachment
 
+// Attachment is a file attached to a task. The file name is only used for
+// display and downloads; the content is stored under an opaque storage key.
+// Attachments uploaded before the size, the content type and the checksum
+// were recorded have an empty checksum.
 type Attachment struct {
-	ID        int        `json:"id"`
-	TaskID    int        `json:"task_id"`
-	FileName  string     `json:"file_name"`
-	CreatedAt time.Time  `json:"created_at"`
-	UpdatedAt *time.Time `json:"updated_at"`
+	ID          int        `json:"id"`
+	TaskID      int        `json:"task_id"`
+	FileName    string     `json:"file_name"`
+	StorageKey  string     `json:"storage_key"`
+	Size        int64      `json:"size"`
+	ContentType string     `json:"content_type"`
+	SHA256      string     `json:"sha256"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at"`
 }
 
 type TaskExpiringMsg struct {
-	Task *Task `json:"task"`
+	Task  *Task  `json:"task"`
+	Email string `json:"email,omitempty"`
 }
 
 type TaskExpiredMsg struct {
-	Task *Task `json:"task"`
+	Task  *Task  `json:"task"`
+	Email string `json:"email,omitempty"`
 }
 
-func NewTask(name string, expiresAt *time.Time) *Task {
+type TaskCommentedMsg struct {
+	Task    *Task        `json:"task"`
+	Comment *TaskComment `json:"comment"`
+}
+
+func NewTask(name string, description string, priority Priority, expiresAt *time.Time) *Task {
 	now := UTCNow()
 
 	return &Task{
-		Name:      name,
-		ExpiresAt: expiresAt,
-		CreatedAt: now,
+		Name:        name,
+		Description: description,
+		Priority:    priority,
+		ExpiresAt:   expiresAt,
+		CreatedAt:   now,
 	}
 }
 
-func (t *Task) Update(name string, expiresAt *time.Time) {
+func (t *Task) Update(name string, description string, priority Priority, expiresAt *time.Time) {
 	now := UTCNow()
 
 	t.Name = name
+	t.Description = description
+	t.Priority = priority
 	t.ExpiresAt = expiresAt
 	t.ExpiringInfoAt = nil
 	t.ExpiredInfoAt = nil
 	t.UpdatedAt = &now
 }
 
+// SetRecurrence sets the recurrence rule of the task. The series starts from
+// the expiration of the task, or from its creation when it does not expire.
+// Changing or clearing the rule starts a new series, so the next occurrence
+// is generated again.
+func (t *Task) SetRecurrence(rule string, timezone string) {
+	if rule != t.Recurrence {
+		t.RecurredAt = nil
+	}
+
+	if rule == "" {
+		t.Recurrence = ""
+		t.RecurrenceTimezone = ""
+		t.RecurrenceStart = nil
+		return
+	}
+
+	start := t.CreatedAt
+	if t.ExpiresAt != nil {
+		start = *t.ExpiresAt
+	}
+
+	t.Recurrence = rule
+	t.RecurrenceTimezone = timezone
+	t.RecurrenceStart = &start
+}
+
+func (t *Task) IsRecurring() bool {
+	return t.Recurrence != ""
+}
+
+// NextOccurrence returns a new task for the next occurrence of a recurring
+// task and marks the task as recurred, so that each occurrence is generated
+// only once. Nil is returned when the task does not recur.
+func (t *Task) NextOccurrence(now time.Time) (*Task, error) {
+	if !t.IsRecurring() || t.RecurredAt != nil || t.RecurrenceStart == nil {
+		return nil, nil
+	}
+
+	loc, err := time.LoadLocation(t.RecurrenceTimezone)
+	if err != nil {
+		return nil, err
+	}
+
+	after := now
+	if t.ExpiresAt != nil && t.ExpiresAt.After(after) {
+		after = *t.ExpiresAt
+	}
+
+	expiresAt, err := NextOccurrence(t.Recurrence, *t.RecurrenceStart, after, loc)
+	if err != nil || expiresAt == nil {
+		return nil, err
+	}
+
+	next := NewTask(t.Name, t.Description, t.Priority, expiresAt)
+	next.UserID = t.UserID
+	next.ProjectID = t.ProjectID
+	next.AssigneeUserID = t.AssigneeUserID
+	next.AssigneeEmail = t.AssigneeEmail
+	next.Recurrence = t.Recurrence
+	next.RecurrenceTimezone = t.RecurrenceTimezone
+	next.RecurrenceStart = t.RecurrenceStart
+	next.AutoComplete = t.AutoComplete
+
+	t.RecurredAt = &now
+	t.UpdatedAt = &now
+
+	return next, nil
+}
+
+// ShouldAutoComplete reports whether the task is set to be completed
+// automatically, all of its checklist items have been checked and it is not
+// blocked.
+func (t *Task) ShouldAutoComplete() bool {
+	return t.AutoComplete && t.CompletedAt == nil && t.Items.IsCompleted() && !t.IsBlocked()
+}
+
 func (t *Task) SetExpiringInfoAt() {
 	now := UTCNow()
 
@@ -79,6 +197,88 @@ func (t *Task) SetCompleted() {
 	t.UpdatedAt = &now
 }
 
+// Reopen marks a completed task as active again. A recurring task that has
+// already created its next occurrence does not create another one.
+func (t *Task) Reopen() {
+	now := UTCNow()
+
+	t.CompletedAt = nil
+	t.UpdatedAt = &now
+}
+
+// SetDeleted moves the task to the trash. Deleted tasks are purged after the
+// trash retention window.
+func (t *Task) SetDeleted() {
+	now := UTCNow()
+
+	t.DeletedAt = &now
+	t.UpdatedAt = &now
+}
+
+func (t *Task) Restore() {
+	now := UTCNow()
+
+	t.DeletedAt = nil
+	t.UpdatedAt = &now
+}
+
+func (t *Task) IsDeleted() bool {
+	return t.DeletedAt != nil
+}
+
+// NewAttachment returns an attachment with a random storage key, so that the
+// storage location never depends on the client-supplied file name.
+func NewAttachment(fileName string) *Attachment {
+	return &Attachment{
+		FileName:   fileName,
+		StorageKey: rand.Text(),
+		CreatedAt:  UTCNow(),
+	}
+}
+
+// Replace returns the attachment with a new storage key for replaced content.
+// The stored content of the attachment is left intact, so that it can still
+// be downloaded until the replacement has been committed.
+func (a *Attachment) Replace() *Attachment {
+	now := UTCNow()
+
+	r := *a
+	r.StorageKey = rand.Text()
+	r.UpdatedAt = &now
+
+	return &r
+}
+
+// SetContent records the size, the detected MIME type and the SHA-256
+// checksum of the content of the attachment. The MIME type is sniffed from
+// the content and falls back to the type of the file name extension.
+func (a *Attachment) SetContent(r io.Reader) error {
+	head := make([]byte, 512)
+	n, err := io.ReadFull(r, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return err
+	}
+	head = head[:n]
+
+	hash := sha256.New()
+	hash.Write(head)
+
+	size, err := io.Copy(hash, r)
+	if err != nil {
+		return err
+	}
+
+	a.Size = int64(n) + size
+	a.ContentType = http.DetectContentType(head)
+	a.SHA256 = hex.EncodeToString(hash.Sum(nil))
+
+	if t := mime.TypeByExtension(filepath.Ext(a.FileName)); t != "" && a.ContentType == "application/octet-stream" {
+		a.ContentType = t
+	}
+
+	return nil
+}
+
 func (a *Attachments) Value() (driver.Value, error) {
 	return json.Marshal(a)
 }
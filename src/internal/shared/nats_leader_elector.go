@@ -0,0 +1,99 @@
+package shared
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"log/slog"
+	"sync"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// NATSLeaderElector holds leadership as a key in a KV bucket whose TTL acts
+// as the lease. If the leader stops renewing the key, it expires and another
+// instance takes over.
+type NATSLeaderElector struct {
+	kv        jetstream.KeyValue
+	conn      *nats.Conn
+	config    *Config
+	logger    *slog.Logger
+	id        string
+	mu        sync.Mutex
+	revisions map[string]uint64
+}
+
+var _ LeaderElector = (*NATSLeaderElector)(nil)
+
+func NewNATSLeaderElector(conn *nats.Conn, config *Config, logger *slog.Logger) (*NATSLeaderElector, error) {
+	js, err := jetstream.New(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	kv, err := js.CreateOrUpdateKeyValue(context.Background(), jetstream.KeyValueConfig{
+		Bucket:   "leaders",
+		Replicas: config.Shared.LeaderReplicas,
+		TTL:      config.Shared.LeaderLeaseTTL,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &NATSLeaderElector{
+		kv:        kv,
+		conn:      conn,
+		config:    config,
+		logger:    logger,
+		id:        rand.Text(),
+		revisions: make(map[string]uint64),
+	}, nil
+}
+
+func (e *NATSLeaderElector) IsLeader(ctx context.Context, name string) (bool, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if revision, found := e.revisions[name]; found {
+		revision, err := e.kv.Update(ctx, name, []byte(e.id), revision)
+		if err == nil {
+			e.revisions[name] = revision
+			return true, nil
+		}
+
+		e.logger.Warn("leadership lost", slog.String("name", name), slog.Any("error", err))
+		delete(e.revisions, name)
+	}
+
+	revision, err := e.kv.Create(ctx, name, []byte(e.id))
+	if errors.Is(err, jetstream.ErrKeyExists) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	e.logger.Info("leadership acquired", slog.String("name", name))
+	e.revisions[name] = revision
+
+	return true, nil
+}
+
+func (e *NATSLeaderElector) Resign(ctx context.Context, name string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	revision, found := e.revisions[name]
+	if !found {
+		return nil
+	}
+
+	delete(e.revisions, name)
+
+	if err := e.kv.Delete(ctx, name, jetstream.LastRevision(revision)); err != nil && !errors.Is(err, jetstream.ErrKeyNotFound) {
+		return err
+	}
+
+	return nil
+}
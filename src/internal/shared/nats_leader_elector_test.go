@@ -0,0 +1,108 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// fakeLeaderKV keeps the keys of a KV bucket with their revisions. Deleting a
+// key stands for its expiry as well.
+type fakeLeaderKV struct {
+	jetstream.KeyValue
+	revision  uint64
+	revisions map[string]uint64
+}
+
+func (kv *fakeLeaderKV) Create(_ context.Context, key string, _ []byte, _ ...jetstream.KVCreateOpt) (uint64, error) {
+	if _, found := kv.revisions[key]; found {
+		return 0, jetstream.ErrKeyExists
+	}
+
+	kv.revision++
+	kv.revisions[key] = kv.revision
+
+	return kv.revision, nil
+}
+
+func (kv *fakeLeaderKV) Update(_ context.Context, key string, _ []byte, revision uint64) (uint64, error) {
+	if current, found := kv.revisions[key]; !found || current != revision {
+		return 0, errors.New("wrong last sequence")
+	}
+
+	kv.revision++
+	kv.revisions[key] = kv.revision
+
+	return kv.revision, nil
+}
+
+func (kv *fakeLeaderKV) Delete(_ context.Context, key string, _ ...jetstream.KVDeleteOpt) error {
+	delete(kv.revisions, key)
+	return nil
+}
+
+func newTestNATSLeaderElector(kv jetstream.KeyValue) *NATSLeaderElector {
+	return &NATSLeaderElector{
+		kv:        kv,
+		logger:    slog.New(slog.NewTextHandler(io.Discard, nil)),
+		id:        "instance",
+		revisions: make(map[string]uint64),
+	}
+}
+
+func TestNATSLeaderElector(t *testing.T) {
+	ctx := t.Context()
+	kv := &fakeLeaderKV{revisions: make(map[string]uint64)}
+	a := newTestNATSLeaderElector(kv)
+	b := newTestNATSLeaderElector(kv)
+
+	isLeader := func(e *NATSLeaderElector, want bool) {
+		t.Helper()
+
+		got, err := e.IsLeader(ctx, "taskchecker")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("IsLeader() = %v, want %v", got, want)
+		}
+	}
+
+	isLeader(a, true)
+	isLeader(b, false)
+
+	// The leader renews its lease.
+	isLeader(a, true)
+	isLeader(b, false)
+
+	// The lease expires and another instance takes over.
+	delete(kv.revisions, "taskchecker")
+	isLeader(b, true)
+	isLeader(a, false)
+
+	if err := b.Resign(ctx, "taskchecker"); err != nil {
+		t.Fatal(err)
+	}
+
+	isLeader(a, true)
+
+	// Resigning without leadership has no effect.
+	if err := b.Resign(ctx, "taskchecker"); err != nil {
+		t.Fatal(err)
+	}
+
+	isLeader(a, true)
+}
+
+func TestNullLeaderElector(t *testing.T) {
+	e := &NullLeaderElector{}
+
+	got, err := e.IsLeader(t.Context(), "taskchecker")
+	if err != nil || !got {
+		t.Errorf("IsLeader() = %v, %v, want true, nil", got, err)
+	}
+}
@@ -57,6 +57,16 @@ func (c *NATSMessagingClient) SendPersistent(ctx context.Context, subject string
 	return err
 }
 
+func (c *NATSMessagingClient) SendPersistentWithID(ctx context.Context, subject string, msgID string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.js.Publish(ctx, subject, payload, jetstream.WithMsgID(msgID))
+	return err
+}
+
 func (c *NATSMessagingClient) Subscribe(ctx context.Context, subject string, handler func(ctx context.Context, msg Message) error) error {
 	sub, err := c.conn.SubscribeSync(subject)
 	if err != nil {
@@ -4,8 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
-	"mime/multipart"
 
 	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
@@ -28,28 +28,30 @@ func NewNATSTaskAttachmentsRepository(conn *nats.Conn, logger *slog.Logger) (*NA
 	return &NATSTaskAttachmentsRepository{js, conn, logger}, nil
 }
 
-func (repo *NATSTaskAttachmentsRepository) GetAttachment(ctx context.Context, taskID int, name string) ([]byte, error) {
+func (repo *NATSTaskAttachmentsRepository) GetAttachment(ctx context.Context, taskID int, attachment *Attachment) (*AttachmentContent, error) {
 	obs, err := repo.js.ObjectStore(ctx, repo.getBucketName(taskID))
 	if err == jetstream.ErrBucketNotFound {
-		return nil, nil
+		return nil, ErrNotFound
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	data, err := obs.GetBytes(ctx, name)
+	info, err := obs.GetInfo(ctx, attachment.StorageKey)
 	if err == jetstream.ErrObjectNotFound || err == jetstream.ErrBucketNotFound {
-		return nil, nil
+		return nil, ErrNotFound
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	return data, nil
+	content := &natsObjectContent{ctx: ctx, obs: obs, name: attachment.StorageKey, size: int64(info.Size)}
+
+	return NewAttachmentContent(attachment, content, content.size, info.Headers.Get("Content-Type"), info.ModTime)
 }
 
-func (repo *NATSTaskAttachmentsRepository) SaveAttachments(ctx context.Context, taskID int, fileHeaders []*multipart.FileHeader) error {
-	if len(fileHeaders) == 0 {
+func (repo *NATSTaskAttachmentsRepository) SaveAttachments(ctx context.Context, taskID int, uploads []*AttachmentUpload) error {
+	if len(uploads) == 0 {
 		return nil
 	}
 
@@ -61,14 +63,19 @@ func (repo *NATSTaskAttachmentsRepository) SaveAttachments(ctx context.Context,
 		return err
 	}
 
-	for _, fileHeader := range fileHeaders {
-		srcFile, err := fileHeader.Open()
+	for _, upload := range uploads {
+		srcFile, err := upload.File.Open()
 		if err != nil {
 			return err
 		}
 		defer srcFile.Close()
 
-		if _, err := obs.Put(ctx, jetstream.ObjectMeta{Name: fileHeader.Filename}, srcFile); err != nil {
+		meta := jetstream.ObjectMeta{
+			Name:    upload.Attachment.StorageKey,
+			Headers: nats.Header{"Content-Type": []string{upload.Attachment.ContentType}},
+		}
+
+		if _, err := obs.Put(ctx, meta, srcFile); err != nil {
 			return err
 		}
 	}
@@ -76,7 +83,7 @@ func (repo *NATSTaskAttachmentsRepository) SaveAttachments(ctx context.Context,
 	return nil
 }
 
-func (repo *NATSTaskAttachmentsRepository) DeleteAttachments(ctx context.Context, taskID int, deleted map[int]string) error {
+func (repo *NATSTaskAttachmentsRepository) DeleteAttachments(ctx context.Context, taskID int, deleted []*Attachment) error {
 	if len(deleted) == 0 {
 		return nil
 	}
@@ -89,8 +96,8 @@ func (repo *NATSTaskAttachmentsRepository) DeleteAttachments(ctx context.Context
 		return err
 	}
 
-	for _, name := range deleted {
-		if err := obs.Delete(ctx, name); err != nil && err != jetstream.ErrObjectNotFound {
+	for _, a := range deleted {
+		if err := obs.Delete(ctx, a.StorageKey); err != nil && err != jetstream.ErrObjectNotFound {
 			return err
 		}
 	}
@@ -108,3 +115,83 @@ func (repo *NATSTaskAttachmentsRepository) DeleteTask(ctx context.Context, taskI
 func (repo *NATSTaskAttachmentsRepository) getBucketName(taskID int) string {
 	return fmt.Sprintf("task_attachments_%d", taskID)
 }
+
+// natsObjectContent reads an object of an object store from the position of
+// the last seek. Objects cannot be read from an offset, so seeking forwards
+// skips data and seeking backwards reads the object again from the start.
+type natsObjectContent struct {
+	ctx    context.Context
+	obs    jetstream.ObjectStore
+	name   string
+	size   int64
+	offset int64
+	pos    int64
+	r      io.ReadCloser
+}
+
+func (c *natsObjectContent) Read(p []byte) (int, error) {
+	if c.r == nil || c.offset < c.pos {
+		if err := c.open(); err != nil {
+			return 0, err
+		}
+	}
+
+	if c.pos < c.offset {
+		n, err := io.CopyN(io.Discard, c.r, c.offset-c.pos)
+		c.pos += n
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := c.r.Read(p)
+	c.pos += int64(n)
+	c.offset = c.pos
+
+	return n, err
+}
+
+func (c *natsObjectContent) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += c.offset
+	case io.SeekEnd:
+		offset += c.size
+	default:
+		return 0, errors.New("seek: invalid whence")
+	}
+
+	if offset < 0 {
+		return 0, errors.New("seek: negative position")
+	}
+
+	c.offset = offset
+
+	return offset, nil
+}
+
+func (c *natsObjectContent) Close() error {
+	if c.r == nil {
+		return nil
+	}
+
+	return c.r.Close()
+}
+
+func (c *natsObjectContent) open() error {
+	if err := c.Close(); err != nil {
+		return err
+	}
+
+	r, err := c.obs.Get(c.ctx, c.name)
+	if err != nil {
+		c.r = nil
+		return err
+	}
+
+	c.r = r
+	c.pos = 0
+
+	return nil
+}
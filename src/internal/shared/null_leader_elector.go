@@ -0,0 +1,15 @@
+package shared
+
+import "context"
+
+type NullLeaderElector struct{}
+
+var _ LeaderElector = (*NullLeaderElector)(nil)
+
+func (e *NullLeaderElector) IsLeader(ctx context.Context, name string) (bool, error) {
+	return true, nil
+}
+
+func (e *NullLeaderElector) Resign(ctx context.Context, name string) error {
+	return nil
+}
@@ -0,0 +1,40 @@
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type OutboxMessage struct {
+	ID        int             `json:"id"`
+	Subject   string          `json:"subject"`
+	Data      json.RawMessage `json:"data"`
+	CreatedAt time.Time       `json:"created_at"`
+	SentAt    *time.Time      `json:"sent_at"`
+}
+
+func NewOutboxMessage(subject string, data any) (*OutboxMessage, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OutboxMessage{
+		Subject:   subject,
+		Data:      payload,
+		CreatedAt: UTCNow(),
+	}, nil
+}
+
+// MsgID is used as the Nats-Msg-Id header so that JetStream drops messages
+// that are relayed more than once.
+func (m *OutboxMessage) MsgID() string {
+	return fmt.Sprintf("outbox.%d", m.ID)
+}
+
+func (m *OutboxMessage) SetSent() {
+	now := UTCNow()
+
+	m.SentAt = &now
+}
@@ -0,0 +1,13 @@
+package shared
+
+import (
+	"context"
+	"time"
+)
+
+type OutboxRepository interface {
+	Create(ctx context.Context, msg *OutboxMessage) error
+	Update(ctx context.Context, msg *OutboxMessage) error
+	GetPending(ctx context.Context, limit int) ([]*OutboxMessage, error)
+	DeleteSent(ctx context.Context, d time.Duration) (int64, error)
+}
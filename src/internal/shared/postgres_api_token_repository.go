@@ -0,0 +1,180 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+)
+
+type PostgresAPITokenRepository struct {
+	db DB
+}
+
+var _ APITokenRepository = (*PostgresAPITokenRepository)(nil)
+
+func NewPostgresAPITokenRepository(db DB) *PostgresAPITokenRepository {
+	return &PostgresAPITokenRepository{db}
+}
+
+func (repo *PostgresAPITokenRepository) Create(ctx context.Context, token *APIToken) error {
+	user, err := GetUserContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Tasks shared with the user by email address are accessible with the
+	// token only if the address was verified when the token was created.
+	token.UserID = user.ID
+	token.UserEmail = user.VerifiedEmail()
+
+	query := `
+		INSERT INTO api_token
+			(user_id, user_email, name, token_prefix, token_hash, scopes, expires_at, last_used_at, created_at, revoked_at)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id
+	`
+
+	return repo.db.QueryRowContext(
+		ctx,
+		query,
+		token.UserID, token.UserEmail, token.Name, token.TokenPrefix, token.TokenHash, token.Scopes, token.ExpiresAt, token.LastUsedAt, token.CreatedAt, token.RevokedAt,
+	).Scan(&token.ID)
+}
+
+func (repo *PostgresAPITokenRepository) Update(ctx context.Context, token *APIToken) error {
+	user, _ := GetUserContext(ctx)
+
+	query := `
+		UPDATE api_token
+		SET
+			name = $1,
+			last_used_at = $2,
+			revoked_at = $3
+		WHERE
+			id = $4
+	`
+	args := []any{token.Name, token.LastUsedAt, token.RevokedAt, token.ID}
+
+	if user != nil {
+		query += "AND user_id = $5"
+		args = append(args, user.ID)
+	}
+
+	_, err := repo.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (repo *PostgresAPITokenRepository) GetByID(ctx context.Context, id int) (*APIToken, error) {
+	user, _ := GetUserContext(ctx)
+
+	where := `
+		WHERE id = $1
+	`
+	args := []any{id}
+
+	if user != nil {
+		where += "AND user_id = $2"
+		args = append(args, user.ID)
+	}
+
+	tokens, err := repo.getTokens(ctx, where, "", args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return tokens[0], nil
+}
+
+func (repo *PostgresAPITokenRepository) GetByHash(ctx context.Context, hash string) (*APIToken, error) {
+	where := `
+		WHERE token_hash = $1
+	`
+
+	tokens, err := repo.getTokens(ctx, where, "", hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return tokens[0], nil
+}
+
+func (repo *PostgresAPITokenRepository) GetAll(ctx context.Context) ([]*APIToken, error) {
+	user, err := GetUserContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	where := `
+		WHERE user_id = $1
+		AND revoked_at IS NULL
+	`
+
+	orderBy := "ORDER BY created_at DESC"
+
+	return repo.getTokens(ctx, where, orderBy, user.ID)
+}
+
+func (repo *PostgresAPITokenRepository) getTokens(ctx context.Context, where string, orderBy string, args ...any) ([]*APIToken, error) {
+	var tokens []*APIToken
+
+	query := fmt.Sprintf(`
+		SELECT
+			id,
+			user_id,
+			user_email,
+			name,
+			token_prefix,
+			token_hash,
+			scopes,
+			expires_at,
+			last_used_at,
+			created_at,
+			revoked_at
+		FROM
+			api_token
+		%s
+		%s
+	`, where, orderBy)
+
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		t := &APIToken{}
+
+		if err := rows.Scan(
+			&t.ID,
+			&t.UserID,
+			&t.UserEmail,
+			&t.Name,
+			&t.TokenPrefix,
+			&t.TokenHash,
+			&t.Scopes,
+			&t.ExpiresAt,
+			&t.LastUsedAt,
+			&t.CreatedAt,
+			&t.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
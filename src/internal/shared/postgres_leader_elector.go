@@ -0,0 +1,82 @@
+package shared
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"sync"
+)
+
+// PostgresLeaderElector holds leadership as a session-level advisory lock on
+// a dedicated connection. If the leader dies, its session ends, the lock is
+// released and another instance takes over.
+type PostgresLeaderElector struct {
+	db     *sql.DB
+	logger *slog.Logger
+	mu     sync.Mutex
+	conns  map[string]*sql.Conn
+}
+
+var _ LeaderElector = (*PostgresLeaderElector)(nil)
+
+func NewPostgresLeaderElector(db *sql.DB, logger *slog.Logger) *PostgresLeaderElector {
+	return &PostgresLeaderElector{
+		db:     db,
+		logger: logger,
+		conns:  make(map[string]*sql.Conn),
+	}
+}
+
+func (e *PostgresLeaderElector) IsLeader(ctx context.Context, name string) (bool, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if conn, found := e.conns[name]; found {
+		err := conn.PingContext(ctx)
+		if err == nil {
+			return true, nil
+		}
+
+		e.logger.Warn("leadership lost", slog.String("name", name), slog.Any("error", err))
+		conn.Close()
+		delete(e.conns, name)
+	}
+
+	conn, err := e.db.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock(hashtext($1))", name).Scan(&acquired); err != nil {
+		conn.Close()
+		return false, err
+	}
+
+	if !acquired {
+		conn.Close()
+		return false, nil
+	}
+
+	e.logger.Info("leadership acquired", slog.String("name", name))
+	e.conns[name] = conn
+
+	return true, nil
+}
+
+func (e *PostgresLeaderElector) Resign(ctx context.Context, name string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	conn, found := e.conns[name]
+	if !found {
+		return nil
+	}
+
+	delete(e.conns, name)
+
+	_, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock(hashtext($1))", name)
+
+	return errors.Join(err, conn.Close())
+}
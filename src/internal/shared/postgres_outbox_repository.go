@@ -0,0 +1,116 @@
+package shared
+
+import (
+	"context"
+	"time"
+)
+
+type PostgresOutboxRepository struct {
+	db DB
+}
+
+var _ OutboxRepository = (*PostgresOutboxRepository)(nil)
+
+func NewPostgresOutboxRepository(db DB) *PostgresOutboxRepository {
+	return &PostgresOutboxRepository{db}
+}
+
+func (repo *PostgresOutboxRepository) Create(ctx context.Context, msg *OutboxMessage) error {
+	query := `
+		INSERT INTO outbox
+			(subject, data, created_at, sent_at)
+		VALUES
+			($1, $2, $3, $4)
+		RETURNING id
+	`
+
+	return repo.db.QueryRowContext(
+		ctx,
+		query,
+		msg.Subject, string(msg.Data), msg.CreatedAt, msg.SentAt,
+	).Scan(&msg.ID)
+}
+
+func (repo *PostgresOutboxRepository) Update(ctx context.Context, msg *OutboxMessage) error {
+	query := `
+		UPDATE outbox
+		SET
+			sent_at = $1
+		WHERE
+			id = $2
+	`
+
+	_, err := repo.db.ExecContext(ctx, query, msg.SentAt, msg.ID)
+	return err
+}
+
+// GetPending locks the returned rows until the end of the transaction.
+// Rows locked by another relay are skipped.
+func (repo *PostgresOutboxRepository) GetPending(ctx context.Context, limit int) ([]*OutboxMessage, error) {
+	var msgs []*OutboxMessage
+
+	query := `
+		SELECT
+			id,
+			subject,
+			data,
+			created_at,
+			sent_at
+		FROM
+			outbox
+		WHERE sent_at IS NULL
+		ORDER BY id ASC
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, err := repo.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		m := &OutboxMessage{}
+
+		if err := rows.Scan(
+			&m.ID,
+			&m.Subject,
+			&m.Data,
+			&m.CreatedAt,
+			&m.SentAt,
+		); err != nil {
+			return nil, err
+		}
+
+		msgs = append(msgs, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return msgs, nil
+}
+
+func (repo *PostgresOutboxRepository) DeleteSent(ctx context.Context, d time.Duration) (int64, error) {
+	t := UTCNow().Add(-d)
+
+	query := `
+		DELETE FROM outbox
+		WHERE sent_at IS NOT NULL
+		AND sent_at < $1
+	`
+
+	result, err := repo.db.ExecContext(ctx, query, t)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,188 @@
+package shared
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// pgUniqueViolation is the Postgres error code of a unique constraint
+// violation.
+const pgUniqueViolation = "23505"
+
+type PostgresProjectRepository struct {
+	db DB
+}
+
+var _ ProjectRepository = (*PostgresProjectRepository)(nil)
+
+func NewPostgresProjectRepository(db DB) *PostgresProjectRepository {
+	return &PostgresProjectRepository{db}
+}
+
+func (repo *PostgresProjectRepository) Create(ctx context.Context, project *Project) error {
+	user, err := GetUserContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	project.UserID = user.ID
+
+	query := `
+		INSERT INTO project
+			(user_id, name, expiration_days, created_at, updated_at)
+		VALUES
+			($1, $2, $3, $4, $5)
+		RETURNING id
+	`
+
+	err = repo.db.QueryRowContext(
+		ctx,
+		query,
+		project.UserID, project.Name, project.ExpirationDays, project.CreatedAt, project.UpdatedAt,
+	).Scan(&project.ID)
+
+	return projectError(err)
+}
+
+func (repo *PostgresProjectRepository) Update(ctx context.Context, project *Project) error {
+	user, err := GetUserContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE project
+		SET
+			name = $1,
+			expiration_days = $2,
+			updated_at = $3
+		WHERE
+			id = $4
+			AND user_id = $5
+	`
+
+	_, err = repo.db.ExecContext(ctx, query, project.Name, project.ExpirationDays, project.UpdatedAt, project.ID, user.ID)
+
+	return projectError(err)
+}
+
+// Delete removes the project. Its tasks are kept without a project.
+func (repo *PostgresProjectRepository) Delete(ctx context.Context, id int) error {
+	user, err := GetUserContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		DELETE FROM project
+		WHERE id = $1
+		AND user_id = $2
+	`
+
+	_, err = repo.db.ExecContext(ctx, query, id, user.ID)
+	return err
+}
+
+func (repo *PostgresProjectRepository) GetByID(ctx context.Context, id int) (*Project, error) {
+	user, err := GetUserContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	where := `
+		WHERE id = $1
+		AND user_id = $2
+	`
+
+	projects, err := repo.getProjects(ctx, where, "", id, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(projects) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return projects[0], nil
+}
+
+func (repo *PostgresProjectRepository) GetAll(ctx context.Context) ([]*Project, error) {
+	user, err := GetUserContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	where := `
+		WHERE user_id = $1
+	`
+
+	orderBy := "ORDER BY lower(name), id"
+
+	return repo.getProjects(ctx, where, orderBy, user.ID)
+}
+
+func (repo *PostgresProjectRepository) getProjects(ctx context.Context, where string, orderBy string, args ...any) ([]*Project, error) {
+	var projects []*Project
+
+	query := fmt.Sprintf(`
+		SELECT
+			id,
+			user_id,
+			name,
+			expiration_days,
+			created_at,
+			updated_at
+		FROM
+			project
+		%s
+		%s
+	`, where, orderBy)
+
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		p := &Project{}
+
+		if err := rows.Scan(
+			&p.ID,
+			&p.UserID,
+			&p.Name,
+			&p.ExpirationDays,
+			&p.CreatedAt,
+			&p.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		projects = append(projects, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return projects, nil
+}
+
+// projectError maps a violation of the unique project name to ErrDuplicate.
+func projectError(err error) error {
+	var pgErr *pgconn.PgError
+
+	if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+		return ErrDuplicate
+	}
+
+	if err == sql.ErrNoRows {
+		return ErrNotFound
+	}
+
+	return err
+}
@@ -0,0 +1,123 @@
+package shared
+
+import (
+	"context"
+)
+
+type PostgresTaskBlockerRepository struct {
+	db DB
+}
+
+var _ TaskBlockerRepository = (*PostgresTaskBlockerRepository)(nil)
+
+func NewPostgresTaskBlockerRepository(db DB) *PostgresTaskBlockerRepository {
+	return &PostgresTaskBlockerRepository{db}
+}
+
+// Create makes the task blocked by the blocker. ErrCycle is returned when the
+// blocker already depends on the task, directly or through other tasks.
+// Adding an existing blocker again has no effect, and Create reports whether
+// the blocker was added.
+//
+// Create must run in a transaction. The dependencies of the owner of the task
+// are locked until the transaction ends, so that concurrent links cannot
+// close a cycle that neither of them sees.
+func (repo *PostgresTaskBlockerRepository) Create(ctx context.Context, taskID int, blockerID int) (bool, error) {
+	if taskID == blockerID {
+		return false, ErrCycle
+	}
+
+	query := `
+		SELECT pg_advisory_xact_lock(hashtext('task_blocker:' || user_id))
+		FROM task
+		WHERE id = $1
+	`
+
+	if _, err := repo.db.ExecContext(ctx, query, taskID); err != nil {
+		return false, err
+	}
+
+	query = `
+		WITH RECURSIVE blockers (id) AS (
+			SELECT blocker_id FROM task_blocker WHERE task_id = $1
+			UNION
+			SELECT tb.blocker_id FROM task_blocker tb JOIN blockers b ON tb.task_id = b.id
+		)
+		SELECT EXISTS (SELECT 1 FROM blockers WHERE id = $2)
+	`
+
+	var cycle bool
+
+	if err := repo.db.QueryRowContext(ctx, query, blockerID, taskID).Scan(&cycle); err != nil {
+		return false, err
+	}
+
+	if cycle {
+		return false, ErrCycle
+	}
+
+	query = `
+		INSERT INTO task_blocker
+			(task_id, blocker_id, created_at)
+		VALUES
+			($1, $2, $3)
+		ON CONFLICT (task_id, blocker_id) DO NOTHING
+	`
+
+	result, err := repo.db.ExecContext(ctx, query, taskID, blockerID, UTCNow())
+	if err != nil {
+		return false, err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return count == 1, nil
+}
+
+func (repo *PostgresTaskBlockerRepository) Delete(ctx context.Context, taskID int, blockerID int) error {
+	query := `
+		DELETE FROM task_blocker
+		WHERE task_id = $1
+		AND blocker_id = $2
+	`
+
+	_, err := repo.db.ExecContext(ctx, query, taskID, blockerID)
+	return err
+}
+
+// GetDependentIDs returns the IDs of the tasks blocked by the blocker.
+func (repo *PostgresTaskBlockerRepository) GetDependentIDs(ctx context.Context, blockerID int) ([]int, error) {
+	query := `
+		SELECT task_id
+		FROM task_blocker
+		WHERE blocker_id = $1
+		ORDER BY task_id
+	`
+
+	rows, err := repo.db.QueryContext(ctx, query, blockerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int
+
+	for rows.Next() {
+		var id int
+
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
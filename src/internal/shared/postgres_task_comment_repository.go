@@ -0,0 +1,140 @@
+package shared
+
+import (
+	"context"
+)
+
+type PostgresTaskCommentRepository struct {
+	db DB
+}
+
+var _ TaskCommentRepository = (*PostgresTaskCommentRepository)(nil)
+
+func NewPostgresTaskCommentRepository(db DB) *PostgresTaskCommentRepository {
+	return &PostgresTaskCommentRepository{db}
+}
+
+// Create adds the comment to the task as written by the user in the context.
+func (repo *PostgresTaskCommentRepository) Create(ctx context.Context, comment *TaskComment) error {
+	user, err := GetUserContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	comment.UserID = user.ID
+	comment.UserName = user.Name
+
+	query := `
+		INSERT INTO task_comment
+			(task_id, user_id, user_name, body, created_at, updated_at)
+		VALUES
+			($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`
+
+	return repo.db.QueryRowContext(
+		ctx,
+		query,
+		comment.TaskID, comment.UserID, comment.UserName, comment.Body, comment.CreatedAt, comment.UpdatedAt,
+	).Scan(&comment.ID)
+}
+
+// Update changes a comment of the user in the context.
+func (repo *PostgresTaskCommentRepository) Update(ctx context.Context, comment *TaskComment) error {
+	user, _ := GetUserContext(ctx)
+
+	query := `
+		UPDATE task_comment
+		SET
+			body = $1,
+			updated_at = $2
+		WHERE
+			id = $3
+			AND task_id = $4
+			AND user_id = $5
+	`
+
+	_, err := repo.db.ExecContext(ctx, query, comment.Body, comment.UpdatedAt, comment.ID, comment.TaskID, user.ID)
+	return err
+}
+
+// Delete removes a comment of the user in the context.
+func (repo *PostgresTaskCommentRepository) Delete(ctx context.Context, taskID int, id int) error {
+	user, _ := GetUserContext(ctx)
+
+	query := `
+		DELETE FROM task_comment
+		WHERE id = $1
+		AND task_id = $2
+		AND user_id = $3
+	`
+
+	_, err := repo.db.ExecContext(ctx, query, id, taskID, user.ID)
+	return err
+}
+
+func (repo *PostgresTaskCommentRepository) GetByID(ctx context.Context, taskID int, id int) (*TaskComment, error) {
+	comments, err := repo.getComments(ctx, "WHERE task_id = $1 AND id = $2", taskID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(comments) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return comments[0], nil
+}
+
+func (repo *PostgresTaskCommentRepository) GetByTaskID(ctx context.Context, taskID int) ([]*TaskComment, error) {
+	return repo.getComments(ctx, "WHERE task_id = $1", taskID)
+}
+
+func (repo *PostgresTaskCommentRepository) getComments(ctx context.Context, where string, args ...any) ([]*TaskComment, error) {
+	var comments []*TaskComment
+
+	query := `
+		SELECT
+			id,
+			task_id,
+			user_id,
+			user_name,
+			body,
+			created_at,
+			updated_at
+		FROM
+			task_comment
+	` + where + `
+		ORDER BY created_at ASC, id ASC
+	`
+
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		c := &TaskComment{}
+
+		if err := rows.Scan(
+			&c.ID,
+			&c.TaskID,
+			&c.UserID,
+			&c.UserName,
+			&c.Body,
+			&c.CreatedAt,
+			&c.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		comments = append(comments, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
@@ -0,0 +1,83 @@
+package shared
+
+import (
+	"context"
+)
+
+type PostgresTaskEventRepository struct {
+	db DB
+}
+
+var _ TaskEventRepository = (*PostgresTaskEventRepository)(nil)
+
+func NewPostgresTaskEventRepository(db DB) *PostgresTaskEventRepository {
+	return &PostgresTaskEventRepository{db}
+}
+
+// Create records the events as done by the user in the context. Events
+// created without a user context are recorded as done by the system.
+func (repo *PostgresTaskEventRepository) Create(ctx context.Context, events ...*TaskEvent) error {
+	user, _ := GetUserContext(ctx)
+
+	query := `
+		INSERT INTO task_event
+			(task_id, user_id, user_name, type, data, created_at)
+		VALUES
+			($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`
+
+	for _, e := range events {
+		if user != nil {
+			e.UserID = user.ID
+			e.UserName = user.Name
+		}
+
+		if err := repo.db.QueryRowContext(ctx, query, e.TaskID, e.UserID, e.UserName, e.Type, e.Data, e.CreatedAt).Scan(&e.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (repo *PostgresTaskEventRepository) GetByTaskID(ctx context.Context, taskID int) ([]*TaskEvent, error) {
+	query := `
+		SELECT id, task_id, user_id, user_name, type, data, created_at
+		FROM task_event
+		WHERE task_id = $1
+		ORDER BY created_at DESC, id DESC
+	`
+
+	rows, err := repo.db.QueryContext(ctx, query, taskID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*TaskEvent
+
+	for rows.Next() {
+		e := &TaskEvent{}
+
+		if err := rows.Scan(
+			&e.ID,
+			&e.TaskID,
+			&e.UserID,
+			&e.UserName,
+			&e.Type,
+			&e.Data,
+			&e.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		events = append(events, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
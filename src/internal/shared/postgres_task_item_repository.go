@@ -0,0 +1,126 @@
+package shared
+
+import (
+	"context"
+)
+
+type PostgresTaskItemRepository struct {
+	db DB
+}
+
+var _ TaskItemRepository = (*PostgresTaskItemRepository)(nil)
+
+func NewPostgresTaskItemRepository(db DB) *PostgresTaskItemRepository {
+	return &PostgresTaskItemRepository{db}
+}
+
+// Create appends the item to the end of the checklist of the task.
+func (repo *PostgresTaskItemRepository) Create(ctx context.Context, item *TaskItem) error {
+	query := `
+		INSERT INTO task_item
+			(task_id, name, position, created_at, updated_at, completed_at)
+		VALUES
+			($1, $2, (SELECT COALESCE(MAX(position) + 1, 0) FROM task_item WHERE task_id = $1), $3, $4, $5)
+		RETURNING id, position
+	`
+
+	return repo.db.QueryRowContext(
+		ctx,
+		query,
+		item.TaskID, item.Name, item.CreatedAt, item.UpdatedAt, item.CompletedAt,
+	).Scan(&item.ID, &item.Position)
+}
+
+func (repo *PostgresTaskItemRepository) Update(ctx context.Context, item *TaskItem) error {
+	query := `
+		UPDATE task_item
+		SET
+			name = $1,
+			position = $2,
+			updated_at = $3,
+			completed_at = $4
+		WHERE
+			id = $5
+			AND task_id = $6
+	`
+
+	_, err := repo.db.ExecContext(ctx, query, item.Name, item.Position, item.UpdatedAt, item.CompletedAt, item.ID, item.TaskID)
+	return err
+}
+
+func (repo *PostgresTaskItemRepository) Delete(ctx context.Context, taskID int, id int) error {
+	query := `
+		DELETE FROM task_item
+		WHERE id = $1
+		AND task_id = $2
+	`
+
+	_, err := repo.db.ExecContext(ctx, query, id, taskID)
+	return err
+}
+
+func (repo *PostgresTaskItemRepository) GetByID(ctx context.Context, taskID int, id int) (*TaskItem, error) {
+	items, err := repo.getItems(ctx, "WHERE task_id = $1 AND id = $2", taskID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return items[0], nil
+}
+
+func (repo *PostgresTaskItemRepository) GetByTaskID(ctx context.Context, taskID int) ([]*TaskItem, error) {
+	return repo.getItems(ctx, "WHERE task_id = $1", taskID)
+}
+
+func (repo *PostgresTaskItemRepository) getItems(ctx context.Context, where string, args ...any) ([]*TaskItem, error) {
+	var items []*TaskItem
+
+	query := `
+		SELECT
+			id,
+			task_id,
+			name,
+			position,
+			created_at,
+			updated_at,
+			completed_at
+		FROM
+			task_item
+	` + where + `
+		ORDER BY position ASC, id ASC
+	`
+
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		i := &TaskItem{}
+
+		if err := rows.Scan(
+			&i.ID,
+			&i.TaskID,
+			&i.Name,
+			&i.Position,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CompletedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
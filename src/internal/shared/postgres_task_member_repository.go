@@ -0,0 +1,45 @@
+package shared
+
+import (
+	"context"
+)
+
+type PostgresTaskMemberRepository struct {
+	db DB
+}
+
+var _ TaskMemberRepository = (*PostgresTaskMemberRepository)(nil)
+
+func NewPostgresTaskMemberRepository(db DB) *PostgresTaskMemberRepository {
+	return &PostgresTaskMemberRepository{db}
+}
+
+// Create adds the member to the task. Inviting a member again changes the
+// role of the existing member.
+func (repo *PostgresTaskMemberRepository) Create(ctx context.Context, member *TaskMember) error {
+	query := `
+		INSERT INTO task_member
+			(task_id, user_id, email, role, created_at)
+		VALUES
+			($1, $2, $3, $4, $5)
+		ON CONFLICT (task_id, user_id, email) DO UPDATE SET role = EXCLUDED.role
+		RETURNING id, created_at
+	`
+
+	return repo.db.QueryRowContext(
+		ctx,
+		query,
+		member.TaskID, member.UserID, member.Email, string(member.Role), member.CreatedAt,
+	).Scan(&member.ID, &member.CreatedAt)
+}
+
+func (repo *PostgresTaskMemberRepository) Delete(ctx context.Context, taskID int, id int) error {
+	query := `
+		DELETE FROM task_member
+		WHERE id = $1
+		AND task_id = $2
+	`
+
+	_, err := repo.db.ExecContext(ctx, query, id, taskID)
+	return err
+}
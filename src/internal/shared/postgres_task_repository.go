@@ -2,7 +2,9 @@ package shared
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -17,64 +19,145 @@ func NewPostgresTaskRepository(db DB) *PostgresTaskRepository {
 }
 
 func (repo *PostgresTaskRepository) Create(ctx context.Context, task *Task) error {
-	user, err := GetUserContext(ctx)
-	if err != nil {
-		return err
+	// A new task is owned by the user creating it. The next occurrence of a
+	// recurring task keeps the owner of the previous one, also when it is
+	// created by a collaborator or by a background job without a user.
+	if task.UserID == "" {
+		user, err := GetUserContext(ctx)
+		if err != nil {
+			return err
+		}
+		task.UserID = user.ID
 	}
 
 	query := `
 		INSERT INTO task
-			(user_id, name, expires_at, expiring_info_at, expired_info_at, created_at, updated_at, completed_at)
+			(user_id, project_id, assignee_user_id, assignee_email, name, description, priority, expires_at, recurrence, recurrence_timezone, recurrence_start, recurred_at, auto_complete, expiring_info_at, expired_info_at, created_at, updated_at, completed_at, deleted_at)
 		VALUES
-			($1, $2, $3, $4, $5, $6, $7, $8)
-		RETURNING id
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
+		RETURNING id, version
 	`
 
 	return repo.db.QueryRowContext(
 		ctx,
 		query,
-		user.ID, task.Name, task.ExpiresAt, task.ExpiringInfoAt, task.ExpiredInfoAt, task.CreatedAt, task.UpdatedAt, task.CompletedAt,
-	).Scan(&task.ID)
+		task.UserID, task.ProjectID, task.AssigneeUserID, task.AssigneeEmail, task.Name, task.Description, int(task.Priority), task.ExpiresAt, task.Recurrence, task.RecurrenceTimezone, task.RecurrenceStart, task.RecurredAt, task.AutoComplete, task.ExpiringInfoAt, task.ExpiredInfoAt, task.CreatedAt, task.UpdatedAt, task.CompletedAt, task.DeletedAt,
+	).Scan(&task.ID, &task.Version)
 }
 
+// Update writes the task if it has not been modified since it was read, and
+// returns ErrConflict otherwise. It returns ErrNotFound if the task does not
+// exist or is not visible to the user, and ErrForbidden if the user may only
+// read it.
 func (repo *PostgresTaskRepository) Update(ctx context.Context, task *Task) error {
 	user, _ := GetUserContext(ctx)
 
 	query := `
 		UPDATE task
 		SET
-			name = $1,
-			expires_at = $2,
-			expiring_info_at = $3,
-			expired_info_at = $4,
-			updated_at = $5,
-			completed_at = $6
+			project_id = $1,
+			assignee_user_id = $2,
+			assignee_email = $3,
+			name = $4,
+			description = $5,
+			priority = $6,
+			expires_at = $7,
+			recurrence = $8,
+			recurrence_timezone = $9,
+			recurrence_start = $10,
+			recurred_at = $11,
+			auto_complete = $12,
+			expiring_info_at = $13,
+			expired_info_at = $14,
+			updated_at = $15,
+			completed_at = $16,
+			deleted_at = $17,
+			version = version + 1
 		WHERE
-			id = $7
+			id = $18
+			AND version = $19
     `
-	args := []any{task.Name, task.ExpiresAt, task.ExpiringInfoAt, task.ExpiredInfoAt, task.UpdatedAt, task.CompletedAt, task.ID}
+	args := []any{task.ProjectID, task.AssigneeUserID, task.AssigneeEmail, task.Name, task.Description, int(task.Priority), task.ExpiresAt, task.Recurrence, task.RecurrenceTimezone, task.RecurrenceStart, task.RecurredAt, task.AutoComplete, task.ExpiringInfoAt, task.ExpiredInfoAt, task.UpdatedAt, task.CompletedAt, task.DeletedAt, task.ID, task.Version}
 
 	if user != nil {
-		query += "AND user_id = $8"
-		args = append(args, user.ID)
+		query += "AND " + taskAccessCondition("task", user, &args, taskEditRoles) + "\n"
 	}
 
-	_, err := repo.db.ExecContext(ctx, query, args...)
-	return err
+	query += "RETURNING version"
+
+	if err := repo.db.QueryRowContext(ctx, query, args...).Scan(&task.Version); err != nil {
+		if err == sql.ErrNoRows {
+			return repo.updateError(ctx, task, user)
+		}
+		return err
+	}
+
+	return nil
 }
 
-func (repo *PostgresTaskRepository) UpdateAttachments(ctx context.Context, taskID int, inserted []string, deleted map[int]string) error {
-	now := UTCNow()
+// updateError tells apart why an update of the task matched no row.
+func (repo *PostgresTaskRepository) updateError(ctx context.Context, task *Task, user *UserContext) error {
+	args := []any{task.ID}
+	readable, editable := "TRUE", "TRUE"
+
+	if user != nil {
+		readable = taskAccessCondition("t", user, &args, taskReadRoles)
+		editable = taskAccessCondition("t", user, &args, taskEditRoles)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s, %s
+		FROM task t
+		WHERE t.id = $1
+	`, readable, editable)
 
+	var canRead, canEdit bool
+
+	if err := repo.db.QueryRowContext(ctx, query, args...).Scan(&canRead, &canEdit); err != nil {
+		if err == sql.ErrNoRows {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	if !canRead {
+		return ErrNotFound
+	}
+
+	if !canEdit {
+		return ErrForbidden
+	}
+
+	return ErrConflict
+}
+
+func (repo *PostgresTaskRepository) UpdateAttachments(ctx context.Context, taskID int, inserted []*Attachment, updated []*Attachment, deleted []*Attachment) error {
 	query := `
 		INSERT INTO attachment
-			(task_id, file_name, created_at)
+			(task_id, file_name, storage_key, size, content_type, sha256, created_at)
 		VALUES
-			($1, $2, $3)
+			($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	for _, a := range inserted {
+		if _, err := repo.db.ExecContext(ctx, query, taskID, a.FileName, a.StorageKey, a.Size, a.ContentType, a.SHA256, a.CreatedAt); err != nil {
+			return err
+		}
+	}
+
+	query = `
+		UPDATE attachment
+		SET
+			storage_key = $1,
+			size = $2,
+			content_type = $3,
+			sha256 = $4,
+			updated_at = $5
+		WHERE id = $6
 	`
 
-	for _, name := range inserted {
-		if _, err := repo.db.ExecContext(ctx, query, taskID, name, now); err != nil {
+	for _, a := range updated {
+		if _, err := repo.db.ExecContext(ctx, query, a.StorageKey, a.Size, a.ContentType, a.SHA256, a.UpdatedAt, a.ID); err != nil {
 			return err
 		}
 	}
@@ -84,8 +167,8 @@ func (repo *PostgresTaskRepository) UpdateAttachments(ctx context.Context, taskI
 		WHERE id = $1
 	`
 
-	for id := range deleted {
-		if _, err := repo.db.ExecContext(ctx, query, id); err != nil {
+	for _, a := range deleted {
+		if _, err := repo.db.ExecContext(ctx, query, a.ID); err != nil {
 			return err
 		}
 	}
@@ -93,21 +176,76 @@ func (repo *PostgresTaskRepository) UpdateAttachments(ctx context.Context, taskI
 	return nil
 }
 
-func (repo *PostgresTaskRepository) Delete(ctx context.Context, id int) error {
+func (repo *PostgresTaskRepository) UpdateTags(ctx context.Context, taskID int, names []string) error {
 	user, _ := GetUserContext(ctx)
 
 	query := `
-		DELETE FROM task
-		WHERE id = $1
+		SELECT t.user_id
+		FROM task t
+		WHERE t.id = $1
 	`
-	args := []any{id}
+	args := []any{taskID}
 
 	if user != nil {
-		query += "AND user_id = $2"
-		args = append(args, user.ID)
+		query += "AND " + taskAccessCondition("t", user, &args, taskEditRoles)
+	}
+
+	var userID string
+
+	if err := repo.db.QueryRowContext(ctx, query, args...).Scan(&userID); err != nil {
+		if err == sql.ErrNoRows {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	query = `
+		DELETE FROM task_tag
+		WHERE task_id = $1
+	`
+
+	if _, err := repo.db.ExecContext(ctx, query, taskID); err != nil {
+		return err
+	}
+
+	now := UTCNow()
+
+	tagQuery := `
+		INSERT INTO tag
+			(user_id, name, color, created_at)
+		VALUES
+			($1, $2, $3, $4)
+		ON CONFLICT (user_id, name) DO UPDATE SET name = EXCLUDED.name
+		RETURNING id
+	`
+
+	taskTagQuery := `
+		INSERT INTO task_tag
+			(task_id, tag_id)
+		VALUES
+			($1, $2)
+		ON CONFLICT DO NOTHING
+	`
+
+	for _, name := range names {
+		var tagID int
+
+		if err := repo.db.QueryRowContext(ctx, tagQuery, userID, name, TagColor(name), now).Scan(&tagID); err != nil {
+			return err
+		}
+
+		if _, err := repo.db.ExecContext(ctx, taskTagQuery, taskID, tagID); err != nil {
+			return err
+		}
 	}
 
-	_, err := repo.db.ExecContext(ctx, query, args...)
+	query = `
+		DELETE FROM tag g
+		WHERE g.user_id = $1
+		AND NOT EXISTS (SELECT 1 FROM task_tag tt WHERE tt.tag_id = g.id)
+	`
+
+	_, err := repo.db.ExecContext(ctx, query, userID)
 	return err
 }
 
@@ -120,8 +258,7 @@ func (repo *PostgresTaskRepository) GetByID(ctx context.Context, id int) (*Task,
 	args := []any{id}
 
 	if user != nil {
-		where += "AND t.user_id = $2"
-		args = append(args, user.ID)
+		where += "AND " + taskAccessCondition("t", user, &args, taskReadRoles)
 	}
 
 	tasks, err := repo.getTasks(ctx, where, "", args...)
@@ -136,48 +273,231 @@ func (repo *PostgresTaskRepository) GetByID(ctx context.Context, id int) (*Task,
 	return tasks[0], nil
 }
 
-func (repo *PostgresTaskRepository) GetActive(ctx context.Context, offset int, limit int) ([]*Task, error) {
+// GetByIDs returns the tasks with the given IDs that are not in the trash.
+func (repo *PostgresTaskRepository) GetByIDs(ctx context.Context, ids []int) ([]*Task, error) {
 	user, _ := GetUserContext(ctx)
 
 	where := `
-		WHERE t.completed_at IS NULL
+		WHERE t.id = ANY($1)
+		AND t.deleted_at IS NULL
+	`
+	args := []any{toInt64s(ids)}
+
+	if user != nil {
+		where += "AND " + taskAccessCondition("t", user, &args, taskReadRoles)
+	}
+
+	orderBy := "ORDER BY t.id ASC"
+
+	return repo.getTasks(ctx, where, orderBy, args...)
+}
+
+func (repo *PostgresTaskRepository) GetActive(ctx context.Context, filter TaskFilter, sort TaskSort, cursor *TaskCursor, limit int) (*TaskPage, error) {
+	return repo.getTasksPage(ctx, "t.completed_at IS NULL AND t.deleted_at IS NULL", filter, taskSortKeys(sort, TaskSortCreated), cursor, limit)
+}
+
+func (repo *PostgresTaskRepository) GetCompleted(ctx context.Context, filter TaskFilter, sort TaskSort, cursor *TaskCursor, limit int) (*TaskPage, error) {
+	return repo.getTasksPage(ctx, "t.completed_at IS NOT NULL AND t.deleted_at IS NULL", filter, taskSortKeys(sort, TaskSortCompleted), cursor, limit)
+}
+
+func (repo *PostgresTaskRepository) GetDeleted(ctx context.Context, cursor *TaskCursor, limit int) (*TaskPage, error) {
+	return repo.getTasksPage(ctx, "t.deleted_at IS NOT NULL", TaskFilter{}, deletedTaskSortKeys, cursor, limit)
+}
+
+// GetCounts counts the tasks of the lists. The filter applies to the active
+// and completed lists, the trash is counted as a whole.
+func (repo *PostgresTaskRepository) GetCounts(ctx context.Context, filter TaskFilter) (*TaskCounts, error) {
+	user, _ := GetUserContext(ctx)
+
+	query := `
+		SELECT
+			COUNT(*) FILTER (WHERE t.completed_at IS NULL AND t.deleted_at IS NULL AND %[1]s),
+			COUNT(*) FILTER (WHERE t.completed_at IS NOT NULL AND t.deleted_at IS NULL AND %[1]s),
+			COUNT(*) FILTER (WHERE t.deleted_at IS NOT NULL)
+		FROM task t
 	`
 	args := []any{}
 
+	query = fmt.Sprintf(query, taskFilterCondition(filter, user, &args))
+
 	if user != nil {
-		where += "AND t.user_id = $1"
-		args = append(args, user.ID)
+		query += "WHERE " + taskAccessCondition("t", user, &args, taskReadRoles)
+	}
+
+	counts := &TaskCounts{}
+
+	err := repo.db.QueryRowContext(ctx, query, args...).Scan(&counts.Active, &counts.Completed, &counts.Deleted)
+	if err != nil {
+		return nil, err
 	}
 
-	orderBy := `
-		ORDER BY t.created_at DESC
-		LIMIT $2 OFFSET $3
+	return counts, nil
+}
+
+// GetAttachmentUsage returns the total size of the attachments of the tasks
+// owned by a user, including the tasks in the trash.
+func (repo *PostgresTaskRepository) GetAttachmentUsage(ctx context.Context, userID string) (int64, error) {
+	query := `
+		SELECT COALESCE(SUM(a.size), 0)
+		FROM attachment a
+		JOIN task t ON t.id = a.task_id
+		WHERE t.user_id = $1
 	`
-	args = append(args, limit, offset)
 
-	return repo.getTasks(ctx, where, orderBy, args...)
+	var usage int64
+
+	if err := repo.db.QueryRowContext(ctx, query, userID).Scan(&usage); err != nil {
+		return 0, err
+	}
+
+	return usage, nil
+}
+
+// LockAttachmentUsage locks the attachment usage of a user until the
+// transaction ends, so that concurrent uploads cannot exceed the quota
+// together. Reading the usage does not take the lock.
+func (repo *PostgresTaskRepository) LockAttachmentUsage(ctx context.Context, userID string) error {
+	_, err := repo.db.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext('attachment_usage:' || $1))", userID)
+	return err
 }
 
-func (repo *PostgresTaskRepository) GetCompleted(ctx context.Context, offset int, limit int) ([]*Task, error) {
+func (repo *PostgresTaskRepository) GetTags(ctx context.Context) ([]*Tag, error) {
 	user, _ := GetUserContext(ctx)
 
-	where := `
-		WHERE t.completed_at IS NOT NULL
+	query := `
+		SELECT id, user_id, name, color, created_at
+		FROM tag
 	`
 	args := []any{}
 
 	if user != nil {
-		where += "AND t.user_id = $1"
+		query += "WHERE user_id = $1"
 		args = append(args, user.ID)
 	}
 
-	orderBy := `
-		ORDER BY t.completed_at DESC
-		LIMIT $2 OFFSET $3
-	`
+	query += " ORDER BY name ASC"
+
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tags []*Tag
+
+	for rows.Next() {
+		t := &Tag{}
+
+		if err := rows.Scan(
+			&t.ID,
+			&t.UserID,
+			&t.Name,
+			&t.Color,
+			&t.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		tags = append(tags, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+func (repo *PostgresTaskRepository) Search(ctx context.Context, query string, language string, offset int, limit int) ([]*TaskSearchResult, error) {
+	user, _ := GetUserContext(ctx)
+
+	config := TextSearchConfig(language)
+	document := fmt.Sprintf("to_tsvector('%s'::regconfig, t.name || ' ' || t.description)", config)
+
+	nameOptions := fmt.Sprintf("StartSel=%s, StopSel=%s, HighlightAll=true", HighlightStart, HighlightStop)
+	descriptionOptions := fmt.Sprintf("StartSel=%s, StopSel=%s, MaxFragments=2, MaxWords=20, MinWords=5", HighlightStart, HighlightStop)
+
+	sqlQuery := fmt.Sprintf(`
+		WITH q AS (
+			SELECT websearch_to_tsquery('%[1]s'::regconfig, $1) AS query
+		)
+		SELECT
+			t.id,
+			ts_rank(%[2]s, q.query) AS rank,
+			ts_headline('%[1]s'::regconfig, t.name, q.query, $2),
+			ts_headline('%[1]s'::regconfig, t.description, q.query, $3)
+		FROM
+			task t, q
+		WHERE t.deleted_at IS NULL
+		AND (
+			%[2]s @@ q.query
+			OR EXISTS (
+				SELECT 1 FROM attachment a
+				WHERE a.task_id = t.id
+				AND to_tsvector('%[1]s'::regconfig, a.file_name) @@ q.query
+			)
+		)
+	`, config, document)
+	args := []any{query, nameOptions, descriptionOptions}
+
+	if user != nil {
+		sqlQuery += "AND " + taskAccessCondition("t", user, &args, taskReadRoles) + "\n"
+	}
+
 	args = append(args, limit, offset)
+	sqlQuery += fmt.Sprintf(`
+		ORDER BY rank DESC, t.id DESC
+		LIMIT $%d OFFSET $%d
+	`, len(args)-1, len(args))
 
-	return repo.getTasks(ctx, where, orderBy, args...)
+	rows, err := repo.db.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*TaskSearchResult
+	var ids []int64
+
+	for rows.Next() {
+		r := &TaskSearchResult{Task: &Task{}}
+
+		if err := rows.Scan(
+			&r.Task.ID,
+			&r.Rank,
+			&r.NameHighlight,
+			&r.DescriptionHighlight,
+		); err != nil {
+			return nil, err
+		}
+
+		results = append(results, r)
+		ids = append(ids, int64(r.Task.ID))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(results) == 0 {
+		return results, nil
+	}
+
+	tasks, err := repo.getTasks(ctx, "WHERE t.id = ANY($1)", "", ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int]*Task, len(tasks))
+	for _, t := range tasks {
+		byID[t.ID] = t
+	}
+
+	for _, r := range results {
+		r.Task = byID[r.Task.ID]
+	}
+
+	return results, nil
 }
 
 func (repo *PostgresTaskRepository) GetExpiring(ctx context.Context, d time.Duration) ([]*Task, error) {
@@ -188,6 +508,7 @@ func (repo *PostgresTaskRepository) GetExpiring(ctx context.Context, d time.Dura
 
 	where := `
 		WHERE t.completed_at IS NULL
+		AND t.deleted_at IS NULL
 		AND t.expiring_info_at IS NULL
 		AND t.expires_at IS NOT NULL
 		AND t.expires_at >= $1
@@ -212,6 +533,7 @@ func (repo *PostgresTaskRepository) GetExpired(ctx context.Context) ([]*Task, er
 
 	where := `
 		WHERE t.completed_at IS NULL
+		AND t.deleted_at IS NULL
 		AND t.expired_info_at IS NULL
 		AND t.expires_at IS NOT NULL
 		AND t.expires_at < $1
@@ -228,7 +550,10 @@ func (repo *PostgresTaskRepository) GetExpired(ctx context.Context) ([]*Task, er
 	return repo.getTasks(ctx, where, orderBy, args...)
 }
 
-func (repo *PostgresTaskRepository) DeleteCompleted(ctx context.Context, d time.Duration) (int64, error) {
+// DeleteCompleted permanently deletes the tasks that were completed more
+// than d ago and returns their IDs, so that their attachments can be deleted
+// as well.
+func (repo *PostgresTaskRepository) DeleteCompleted(ctx context.Context, d time.Duration) ([]int, error) {
 	user, _ := GetUserContext(ctx)
 
 	t := UTCNow().Add(-d)
@@ -237,25 +562,293 @@ func (repo *PostgresTaskRepository) DeleteCompleted(ctx context.Context, d time.
 		DELETE FROM task
 		WHERE completed_at IS NOT NULL
 		AND completed_at < $1
+		AND deleted_at IS NULL
 	`
 	args := []any{t}
 
 	if user != nil {
-		query += "AND user_id = $2"
+		query += "AND user_id = $2\n"
 		args = append(args, user.ID)
 	}
 
-	result, err := repo.db.ExecContext(ctx, query, args...)
+	query += "RETURNING id"
+
+	rows, err := repo.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
+	defer rows.Close()
 
-	count, err := result.RowsAffected()
+	var ids []int
+
+	for rows.Next() {
+		var id int
+
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// PurgeDeleted permanently deletes the tasks that were moved to the trash
+// more than d ago and returns their IDs, so that their attachments can be
+// deleted as well.
+func (repo *PostgresTaskRepository) PurgeDeleted(ctx context.Context, d time.Duration) ([]int, error) {
+	user, _ := GetUserContext(ctx)
+
+	t := UTCNow().Add(-d)
+
+	query := `
+		DELETE FROM task
+		WHERE deleted_at IS NOT NULL
+		AND deleted_at <= $1
+	`
+	args := []any{t}
+
+	if user != nil {
+		query += "AND user_id = $2\n"
+		args = append(args, user.ID)
+	}
+
+	query += "RETURNING id"
+
+	rows, err := repo.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return 0, err
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int
+
+	for rows.Next() {
+		var id int
+
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+var (
+	taskReadRoles = []TaskRole{TaskRoleViewer, TaskRoleEditor}
+	taskEditRoles = []TaskRole{TaskRoleEditor}
+)
+
+// taskAccessCondition matches the tasks the user owns or that are shared
+// with the user in one of the given roles. Tasks in the trash are visible to
+// their owner only.
+func taskAccessCondition(alias string, user *UserContext, args *[]any, roles []TaskRole) string {
+	names := make([]string, len(roles))
+	for i, role := range roles {
+		names[i] = string(role)
+	}
+
+	*args = append(*args, user.ID, user.VerifiedEmail(), names)
+	n := len(*args)
+
+	// The assignee of a task is an editor.
+	return fmt.Sprintf(`(%[1]s.user_id = $%[2]d OR (%[1]s.deleted_at IS NULL AND (
+			('%[5]s' = ANY($%[4]d) AND (%[1]s.assignee_user_id = $%[2]d OR (%[1]s.assignee_user_id = '' AND %[1]s.assignee_email <> '' AND %[1]s.assignee_email = $%[3]d)))
+			OR EXISTS (
+				SELECT 1 FROM task_member m
+				WHERE m.task_id = %[1]s.id
+				AND (m.user_id = $%[2]d OR (m.user_id = '' AND m.email <> '' AND m.email = $%[3]d))
+				AND m.role = ANY($%[4]d)
+			)
+		)))`, alias, n-2, n-1, n, TaskRoleEditor)
+}
+
+// taskFilterCondition returns the condition matching the tasks of the filter
+// and appends its arguments.
+func taskFilterCondition(filter TaskFilter, user *UserContext, args *[]any) string {
+	conditions := []string{"TRUE"}
+
+	if filter.Tag != "" {
+		*args = append(*args, filter.Tag)
+		conditions = append(conditions, fmt.Sprintf(`EXISTS (
+			SELECT 1 FROM task_tag tt
+			JOIN tag g ON g.id = tt.tag_id
+			WHERE tt.task_id = t.id AND g.name = $%d
+		)`, len(*args)))
+	}
+
+	if filter.ProjectID != 0 {
+		*args = append(*args, filter.ProjectID)
+		conditions = append(conditions, fmt.Sprintf("t.project_id = $%d", len(*args)))
+	}
+
+	if filter.AssignedToMe && user != nil {
+		*args = append(*args, user.ID, user.VerifiedEmail())
+		conditions = append(conditions, fmt.Sprintf(
+			"(t.assignee_user_id = $%[1]d OR (t.assignee_user_id = '' AND t.assignee_email <> '' AND t.assignee_email = $%[2]d))",
+			len(*args)-1, len(*args),
+		))
+	}
+
+	return strings.Join(conditions, " AND ")
+}
+
+func toInt64s(values []int) []int64 {
+	v := make([]int64, len(values))
+	for i, value := range values {
+		v[i] = int64(value)
+	}
+	return v
+}
+
+// taskSortKey is a column of a task list sort order. The same keys build
+// both the ORDER BY clause and the keyset condition that continues a list
+// after a cursor, so the two can never disagree.
+type taskSortKey struct {
+	column    string
+	desc      bool
+	nullsLast bool
+	value     func(c *TaskCursor) any
+}
+
+var (
+	taskSortKeyID          = taskSortKey{"t.id", true, false, func(c *TaskCursor) any { return c.ID }}
+	taskSortKeyCreatedAt   = taskSortKey{"t.created_at", true, false, func(c *TaskCursor) any { return c.CreatedAt }}
+	taskSortKeyCompletedAt = taskSortKey{"t.completed_at", true, false, func(c *TaskCursor) any { return timeValue(c.CompletedAt) }}
+	taskSortKeyDeletedAt   = taskSortKey{"t.deleted_at", true, false, func(c *TaskCursor) any { return timeValue(c.DeletedAt) }}
+	taskSortKeyPriority    = taskSortKey{"t.priority", true, false, func(c *TaskCursor) any { return int(c.Priority) }}
+	taskSortKeyExpiresAt   = taskSortKey{"t.expires_at", false, true, func(c *TaskCursor) any { return timeValue(c.ExpiresAt) }}
+	taskSortKeyName        = taskSortKey{"t.name", false, false, func(c *TaskCursor) any { return c.Name }}
+)
+
+var deletedTaskSortKeys = []taskSortKey{taskSortKeyDeletedAt, taskSortKeyID}
+
+func taskSortKeys(sort TaskSort, fallback TaskSort) []taskSortKey {
+	switch sort {
+	case TaskSortCreated:
+		return []taskSortKey{taskSortKeyCreatedAt, taskSortKeyID}
+	case TaskSortCompleted:
+		return []taskSortKey{taskSortKeyCompletedAt, taskSortKeyID}
+	case TaskSortPriority:
+		return []taskSortKey{taskSortKeyPriority, taskSortKeyExpiresAt, taskSortKeyID}
+	case TaskSortExpiration:
+		return []taskSortKey{taskSortKeyExpiresAt, taskSortKeyID}
+	case TaskSortName:
+		return []taskSortKey{taskSortKeyName, taskSortKeyID}
+	default:
+		return taskSortKeys(fallback, TaskSortCreated)
+	}
+}
+
+func taskSortOrderBy(keys []taskSortKey) string {
+	columns := make([]string, len(keys))
+
+	for i, key := range keys {
+		columns[i] = key.column + " ASC"
+		if key.desc {
+			columns[i] = key.column + " DESC"
+		}
+		if key.nullsLast {
+			columns[i] += " NULLS LAST"
+		}
+	}
+
+	return strings.Join(columns, ", ")
+}
+
+// taskCursorCondition matches the tasks that come after the cursor in the
+// order given by keys. A NULL sorts after every value of a NULLS LAST key.
+func taskCursorCondition(keys []taskSortKey, cursor *TaskCursor, args *[]any) string {
+	if len(keys) == 0 {
+		return "FALSE"
+	}
+
+	key := keys[0]
+	value := key.value(cursor)
+
+	if value == nil {
+		if !key.nullsLast {
+			return taskCursorCondition(keys[1:], cursor, args)
+		}
+		if len(keys) == 1 {
+			return "FALSE"
+		}
+		return fmt.Sprintf("(%s IS NULL AND %s)", key.column, taskCursorCondition(keys[1:], cursor, args))
+	}
+
+	*args = append(*args, value)
+	param := fmt.Sprintf("$%d", len(*args))
+
+	after := fmt.Sprintf("%s > %s", key.column, param)
+	if key.desc {
+		after = fmt.Sprintf("%s < %s", key.column, param)
+	}
+	if key.nullsLast {
+		after = fmt.Sprintf("(%s OR %s IS NULL)", after, key.column)
+	}
+
+	if len(keys) == 1 {
+		return after
+	}
+
+	return fmt.Sprintf("(%s OR (%s = %s AND %s))", after, key.column, param, taskCursorCondition(keys[1:], cursor, args))
+}
+
+func timeValue(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+
+	return *t
+}
+
+func (repo *PostgresTaskRepository) getTasksPage(ctx context.Context, condition string, filter TaskFilter, keys []taskSortKey, cursor *TaskCursor, limit int) (*TaskPage, error) {
+	user, _ := GetUserContext(ctx)
+
+	where := "WHERE " + condition + "\n"
+	args := []any{}
+
+	if user != nil {
+		where += "AND " + taskAccessCondition("t", user, &args, taskReadRoles) + "\n"
+	}
+
+	where += "AND " + taskFilterCondition(filter, user, &args) + "\n"
+
+	if cursor != nil {
+		where += "AND " + taskCursorCondition(keys, cursor, &args) + "\n"
+	}
+
+	// One extra task tells whether there is a next page.
+	args = append(args, limit+1)
+	orderBy := fmt.Sprintf(`
+		ORDER BY %s
+		LIMIT $%d
+	`, taskSortOrderBy(keys), len(args))
+
+	tasks, err := repo.getTasks(ctx, where, orderBy, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &TaskPage{Tasks: tasks}
+
+	if limit < len(tasks) {
+		page.Tasks = tasks[:limit]
+		page.Next = NewTaskCursor(page.Tasks[limit-1])
 	}
 
-	return count, nil
+	return page, nil
 }
 
 func (repo *PostgresTaskRepository) getTasks(ctx context.Context, where string, orderBy string, args ...any) ([]*Task, error) {
@@ -265,14 +858,49 @@ func (repo *PostgresTaskRepository) getTasks(ctx context.Context, where string,
 		SELECT
 			t.id,
 			t.user_id,
+			t.project_id,
+			t.assignee_user_id,
+			t.assignee_email,
 			t.name,
+			t.description,
+			t.priority,
 			t.expires_at,
+			t.recurrence,
+			t.recurrence_timezone,
+			t.recurrence_start,
+			t.recurred_at,
+			t.auto_complete,
 			t.expiring_info_at,
 			t.expired_info_at,
 			t.created_at,
 			t.updated_at,
 			t.completed_at,
-			COALESCE(jsonb_agg(a) FILTER (WHERE a.task_id IS NOT NULL), '[]') AS attachments
+			t.deleted_at,
+			t.version,
+			COALESCE(jsonb_agg(a) FILTER (WHERE a.task_id IS NOT NULL), '[]') AS attachments,
+			COALESCE((
+				SELECT jsonb_agg(g ORDER BY g.name)
+				FROM task_tag tt
+				JOIN tag g ON g.id = tt.tag_id
+				WHERE tt.task_id = t.id
+			), '[]') AS tags,
+			COALESCE((
+				SELECT jsonb_agg(i ORDER BY i.position, i.id)
+				FROM task_item i
+				WHERE i.task_id = t.id
+			), '[]') AS items,
+			COALESCE((
+				SELECT jsonb_agg(m ORDER BY m.id)
+				FROM task_member m
+				WHERE m.task_id = t.id
+			), '[]') AS members,
+			COALESCE((
+				SELECT jsonb_agg(jsonb_build_object('id', b.id, 'name', b.name, 'completed_at', b.completed_at) ORDER BY b.id)
+				FROM task_blocker tb
+				JOIN task b ON b.id = tb.blocker_id
+				WHERE tb.task_id = t.id
+				AND b.deleted_at IS NULL
+			), '[]') AS blockers
 		FROM
 			task t
 		LEFT JOIN
@@ -294,14 +922,30 @@ func (repo *PostgresTaskRepository) getTasks(ctx context.Context, where string,
 		if err := rows.Scan(
 			&t.ID,
 			&t.UserID,
+			&t.ProjectID,
+			&t.AssigneeUserID,
+			&t.AssigneeEmail,
 			&t.Name,
+			&t.Description,
+			&t.Priority,
 			&t.ExpiresAt,
+			&t.Recurrence,
+			&t.RecurrenceTimezone,
+			&t.RecurrenceStart,
+			&t.RecurredAt,
+			&t.AutoComplete,
 			&t.ExpiringInfoAt,
 			&t.ExpiredInfoAt,
 			&t.CreatedAt,
 			&t.UpdatedAt,
 			&t.CompletedAt,
+			&t.DeletedAt,
+			&t.Version,
 			&t.Attachments,
+			&t.Tags,
+			&t.Items,
+			&t.Members,
+			&t.Blockers,
 		); err != nil {
 			return nil, err
 		}
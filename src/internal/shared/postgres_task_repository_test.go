@@ -0,0 +1,113 @@
+package shared
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTaskCursorCondition(t *testing.T) {
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		keys     []taskSortKey
+		cursor   *TaskCursor
+		args     []any
+		want     string
+		wantArgs []any
+	}{
+		{
+			name:     "created",
+			keys:     taskSortKeys(TaskSortCreated, TaskSortCreated),
+			cursor:   &TaskCursor{ID: 5, CreatedAt: at},
+			want:     "(t.created_at < $1 OR (t.created_at = $1 AND t.id < $2))",
+			wantArgs: []any{at, 5},
+		},
+		{
+			name:     "parameters follow existing arguments",
+			keys:     taskSortKeys(TaskSortName, TaskSortCreated),
+			cursor:   &TaskCursor{ID: 5, Name: "b"},
+			args:     []any{"user"},
+			want:     "(t.name > $2 OR (t.name = $2 AND t.id < $3))",
+			wantArgs: []any{"user", "b", 5},
+		},
+		{
+			name:     "expiration",
+			keys:     taskSortKeys(TaskSortExpiration, TaskSortCreated),
+			cursor:   &TaskCursor{ID: 5, ExpiresAt: &at},
+			want:     "((t.expires_at > $1 OR t.expires_at IS NULL) OR (t.expires_at = $1 AND t.id < $2))",
+			wantArgs: []any{at, 5},
+		},
+		{
+			name:     "expiration after the last expiring task",
+			keys:     taskSortKeys(TaskSortExpiration, TaskSortCreated),
+			cursor:   &TaskCursor{ID: 5},
+			want:     "(t.expires_at IS NULL AND t.id < $1)",
+			wantArgs: []any{5},
+		},
+		{
+			name:     "priority",
+			keys:     taskSortKeys(TaskSortPriority, TaskSortCreated),
+			cursor:   &TaskCursor{ID: 5, Priority: PriorityHigh},
+			want:     "(t.priority < $1 OR (t.priority = $1 AND (t.expires_at IS NULL AND t.id < $2)))",
+			wantArgs: []any{int(PriorityHigh), 5},
+		},
+		{
+			name:     "missing value of a key that is never null",
+			keys:     taskSortKeys(TaskSortCompleted, TaskSortCreated),
+			cursor:   &TaskCursor{ID: 5},
+			want:     "t.id < $1",
+			wantArgs: []any{5},
+		},
+		{
+			name:     "deleted",
+			keys:     deletedTaskSortKeys,
+			cursor:   &TaskCursor{ID: 5, DeletedAt: &at},
+			want:     "(t.deleted_at < $1 OR (t.deleted_at = $1 AND t.id < $2))",
+			wantArgs: []any{at, 5},
+		},
+		{
+			name:   "no keys",
+			cursor: &TaskCursor{ID: 5},
+			want:   "FALSE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := tt.args
+
+			got := taskCursorCondition(tt.keys, tt.cursor, &args)
+
+			if got != tt.want {
+				t.Errorf("condition = %q, want %q", got, tt.want)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("args = %v, want %v", args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestTaskSortOrderBy(t *testing.T) {
+	tests := []struct {
+		sort TaskSort
+		want string
+	}{
+		{TaskSortCreated, "t.created_at DESC, t.id DESC"},
+		{TaskSortCompleted, "t.completed_at DESC, t.id DESC"},
+		{TaskSortPriority, "t.priority DESC, t.expires_at ASC NULLS LAST, t.id DESC"},
+		{TaskSortExpiration, "t.expires_at ASC NULLS LAST, t.id DESC"},
+		{TaskSortName, "t.name ASC, t.id DESC"},
+		{"unknown", "t.created_at DESC, t.id DESC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.sort), func(t *testing.T) {
+			if got := taskSortOrderBy(taskSortKeys(tt.sort, TaskSortCreated)); got != tt.want {
+				t.Errorf("order by = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -15,7 +15,15 @@ func NewPostgresTxManager(db *sql.DB) *PostgresTxManager {
 func (m *PostgresTxManager) RunInTx(fn func(txc TxContext) error) error {
 	return runInTx(m.db, func(tx *sql.Tx) error {
 		return fn(TxContext{
-			TaskRepository: NewPostgresTaskRepository(tx),
+			TaskRepository:        NewPostgresTaskRepository(tx),
+			TaskItemRepository:    NewPostgresTaskItemRepository(tx),
+			TaskEventRepository:   NewPostgresTaskEventRepository(tx),
+			TaskMemberRepository:  NewPostgresTaskMemberRepository(tx),
+			TaskCommentRepository: NewPostgresTaskCommentRepository(tx),
+			TaskBlockerRepository: NewPostgresTaskBlockerRepository(tx),
+			ProjectRepository:     NewPostgresProjectRepository(tx),
+			APITokenRepository:    NewPostgresAPITokenRepository(tx),
+			OutboxRepository:      NewPostgresOutboxRepository(tx),
 		})
 	})
 }
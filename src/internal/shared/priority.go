@@ -0,0 +1,57 @@
+package shared
+
+import (
+	"errors"
+	"slices"
+)
+
+type Priority int
+
+const (
+	PriorityLow Priority = iota
+	PriorityNormal
+	PriorityHigh
+	PriorityUrgent
+)
+
+var SupportedPriorities = []Priority{
+	PriorityLow,
+	PriorityNormal,
+	PriorityHigh,
+	PriorityUrgent,
+}
+
+var priorityNames = []string{
+	"low",
+	"normal",
+	"high",
+	"urgent",
+}
+
+func ParsePriority(value string) (Priority, error) {
+	i := slices.Index(priorityNames, value)
+	if i < 0 {
+		return 0, errors.New("invalid priority")
+	}
+	return Priority(i), nil
+}
+
+func (p Priority) String() string {
+	if p < PriorityLow || PriorityUrgent < p {
+		return priorityNames[PriorityNormal]
+	}
+	return priorityNames[p]
+}
+
+func (p Priority) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *Priority) UnmarshalText(text []byte) error {
+	v, err := ParsePriority(string(text))
+	if err != nil {
+		return err
+	}
+	*p = v
+	return nil
+}
@@ -0,0 +1,71 @@
+package shared
+
+import "time"
+
+// MaxProjectExpirationDays limits the default expiration offset of a project.
+const MaxProjectExpirationDays = 3650
+
+type Project struct {
+	ID             int        `json:"id"`
+	UserID         string     `json:"user_id"`
+	Name           string     `json:"name"`
+	ExpirationDays int        `json:"expiration_days"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      *time.Time `json:"updated_at"`
+}
+
+func NewProject(name string, expirationDays int) *Project {
+	now := UTCNow()
+
+	return &Project{
+		Name:           name,
+		ExpirationDays: expirationDays,
+		CreatedAt:      now,
+	}
+}
+
+func (p *Project) Update(name string, expirationDays int) {
+	now := UTCNow()
+
+	p.Name = name
+	p.ExpirationDays = expirationDays
+	p.UpdatedAt = &now
+}
+
+// DefaultExpiresAt returns the expiration of a task created in the project
+// without one, or nil when the project has no default expiration.
+func (p *Project) DefaultExpiresAt(now time.Time) *time.Time {
+	if p.ExpirationDays <= 0 {
+		return nil
+	}
+
+	expiresAt := now.AddDate(0, 0, p.ExpirationDays)
+
+	return &expiresAt
+}
+
+// SetProject moves the task to the project, or out of any project when the
+// project is nil.
+func (t *Task) SetProject(project *Project) {
+	now := UTCNow()
+
+	t.ProjectID = nil
+	if project != nil {
+		t.ProjectID = &project.ID
+	}
+	t.UpdatedAt = &now
+}
+
+// IsInProject tells whether the task is in the project with the ID, or in no
+// project when the ID is nil.
+func (t *Task) IsInProject(projectID *int) bool {
+	if t.ProjectID == nil || projectID == nil {
+		return t.ProjectID == projectID
+	}
+
+	return *t.ProjectID == *projectID
+}
+
+func (p *Project) HasTask(task *Task) bool {
+	return task.IsInProject(&p.ID)
+}
@@ -0,0 +1,11 @@
+package shared
+
+import "context"
+
+type ProjectRepository interface {
+	Create(ctx context.Context, project *Project) error
+	Update(ctx context.Context, project *Project) error
+	Delete(ctx context.Context, id int) error
+	GetByID(ctx context.Context, id int) (*Project, error)
+	GetAll(ctx context.Context) ([]*Project, error)
+}
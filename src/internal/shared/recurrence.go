@@ -0,0 +1,115 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+type RecurrencePreset struct {
+	Name string
+	Rule string
+}
+
+var RecurrencePresets = []RecurrencePreset{
+	{"daily", "FREQ=DAILY"},
+	{"weekdays", "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR"},
+	{"weekly", "FREQ=WEEKLY"},
+	{"biweekly", "FREQ=WEEKLY;INTERVAL=2"},
+	{"monthly", "FREQ=MONTHLY"},
+	{"yearly", "FREQ=YEARLY"},
+}
+
+// NormalizeRecurrence validates an RFC 5545 RRULE value and returns it in
+// canonical form without the "RRULE:" prefix. DTSTART is not accepted
+// because the start of the series is derived from the task itself.
+func NormalizeRecurrence(rule string) (string, error) {
+	rule = strings.ToUpper(strings.TrimSpace(rule))
+	rule = strings.TrimPrefix(rule, "RRULE:")
+
+	if rule == "" {
+		return "", nil
+	}
+
+	if strings.Contains(rule, "DTSTART") {
+		return "", errors.New("DTSTART is not supported")
+	}
+
+	opt, err := rrule.StrToROption(rule)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := rrule.NewRRule(*opt); err != nil {
+		return "", err
+	}
+
+	return opt.RRuleString(), nil
+}
+
+// NextOccurrence returns the first occurrence of the recurrence rule after
+// the given time. Occurrences are computed in loc so that daylight saving
+// time changes do not shift the local time of day. Nil is returned when the
+// rule has no more occurrences.
+func NextOccurrence(rule string, start time.Time, after time.Time, loc *time.Location) (*time.Time, error) {
+	opt, err := rrule.StrToROptionInLocation(rule, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	opt.Dtstart = start.In(loc)
+
+	r, err := rrule.NewRRule(*opt)
+	if err != nil {
+		return nil, err
+	}
+
+	next := r.After(after.In(loc), false)
+	if next.IsZero() {
+		return nil, nil
+	}
+
+	next = next.UTC()
+
+	return &next, nil
+}
+
+// CreateNextOccurrence creates the next occurrence of a recurring task,
+// together with its tags and unchecked checklist items, and marks the task
+// as recurred. The caller is responsible for persisting the updated task.
+// Nil is returned when no occurrence was created.
+func CreateNextOccurrence(ctx context.Context, txc TxContext, task *Task) (*Task, error) {
+	next, err := task.NextOccurrence(UTCNow())
+	if err != nil || next == nil {
+		return nil, err
+	}
+
+	if err := txc.TaskRepository.Create(ctx, next); err != nil {
+		return nil, err
+	}
+
+	for _, member := range task.Members {
+		if err := txc.TaskMemberRepository.Create(ctx, NewTaskMember(next.ID, member.UserID, member.Email, member.Role)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := txc.TaskRepository.UpdateTags(ctx, next.ID, task.Tags.Names()); err != nil {
+		return nil, err
+	}
+
+	if err := txc.TaskEventRepository.Create(ctx, NewTaskEvent(next.ID, TaskEventCreated, TaskEventData{})); err != nil {
+		return nil, err
+	}
+
+	for _, item := range task.Items {
+		if err := txc.TaskItemRepository.Create(ctx, NewTaskItem(next.ID, item.Name)); err != nil {
+			return nil, err
+		}
+	}
+
+	return next, nil
+}
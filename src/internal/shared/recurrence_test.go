@@ -0,0 +1,174 @@
+package shared
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestNextOccurrence(t *testing.T) {
+	helsinki, err := time.LoadLocation("Europe/Helsinki")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 09:00 in Helsinki, before the start of daylight saving time.
+	start := time.Date(2026, 3, 27, 7, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		rule  string
+		after time.Time
+		loc   *time.Location
+		want  *time.Time
+	}{
+		{
+			name:  "daily",
+			rule:  "FREQ=DAILY",
+			after: start,
+			loc:   time.UTC,
+			want:  ptr(time.Date(2026, 3, 28, 7, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:  "daily keeps the local time over daylight saving time",
+			rule:  "FREQ=DAILY",
+			after: time.Date(2026, 3, 28, 12, 0, 0, 0, time.UTC),
+			loc:   helsinki,
+			want:  ptr(time.Date(2026, 3, 29, 6, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:  "weekdays skip the weekend",
+			rule:  "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR",
+			after: start,
+			loc:   time.UTC,
+			want:  ptr(time.Date(2026, 3, 30, 7, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:  "biweekly",
+			rule:  "FREQ=WEEKLY;INTERVAL=2",
+			after: start,
+			loc:   time.UTC,
+			want:  ptr(time.Date(2026, 4, 10, 7, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:  "monthly skips the start when it is later",
+			rule:  "FREQ=MONTHLY",
+			after: time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC),
+			loc:   time.UTC,
+			want:  ptr(time.Date(2026, 5, 27, 7, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:  "count exhausted",
+			rule:  "FREQ=DAILY;COUNT=2",
+			after: start.AddDate(0, 0, 1),
+			loc:   time.UTC,
+		},
+		{
+			name:  "until passed",
+			rule:  "FREQ=DAILY;UNTIL=20260329T000000Z",
+			after: start.AddDate(0, 0, 2),
+			loc:   time.UTC,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NextOccurrence(tt.rule, start, tt.after, tt.loc)
+			if err != nil {
+				t.Fatalf("NextOccurrence() error = %v", err)
+			}
+
+			switch {
+			case tt.want == nil && got != nil:
+				t.Errorf("NextOccurrence() = %v, want nil", *got)
+			case tt.want != nil && got == nil:
+				t.Errorf("NextOccurrence() = nil, want %v", *tt.want)
+			case tt.want != nil && !got.Equal(*tt.want):
+				t.Errorf("NextOccurrence() = %v, want %v", *got, *tt.want)
+			}
+		})
+	}
+}
+
+func TestNextOccurrenceInvalidRule(t *testing.T) {
+	if _, err := NextOccurrence("FREQ=SOMETIMES", time.Now(), time.Now(), time.UTC); err == nil {
+		t.Error("NextOccurrence() error = nil, want error")
+	}
+}
+
+func TestNormalizeRecurrence(t *testing.T) {
+	tests := []struct {
+		rule    string
+		want    string
+		wantErr bool
+	}{
+		{rule: "", want: ""},
+		{rule: "  ", want: ""},
+		{rule: "FREQ=DAILY", want: "FREQ=DAILY"},
+		{rule: "rrule:freq=weekly;interval=2", want: "FREQ=WEEKLY;INTERVAL=2"},
+		{rule: "FREQ=DAILY;DTSTART=20260101T000000Z", wantErr: true},
+		{rule: "FREQ=SOMETIMES", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			got, err := NormalizeRecurrence(tt.rule)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeRecurrence() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeRecurrence() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTaskNextOccurrence(t *testing.T) {
+	now := time.Date(2026, 3, 27, 8, 0, 0, 0, time.UTC)
+	expiresAt := time.Date(2026, 3, 27, 7, 0, 0, 0, time.UTC)
+
+	task := NewTask("Water the plants", "", PriorityNormal, &expiresAt)
+	task.ID = 1
+	task.UserID = "user"
+	task.SetRecurrence("FREQ=DAILY", "UTC")
+
+	next, err := task.NextOccurrence(now)
+	if err != nil {
+		t.Fatalf("NextOccurrence() error = %v", err)
+	}
+	if next == nil || !next.ExpiresAt.Equal(expiresAt.AddDate(0, 0, 1)) {
+		t.Fatalf("NextOccurrence() = %+v, want a task expiring a day later", next)
+	}
+	if next.UserID != task.UserID || next.Recurrence != task.Recurrence {
+		t.Errorf("NextOccurrence() = %+v, want the owner and the rule of the task", next)
+	}
+
+	if again, _ := task.NextOccurrence(now); again != nil {
+		t.Errorf("NextOccurrence() again = %+v, want nil", again)
+	}
+
+	tests := []struct {
+		name         string
+		rule         string
+		wantRecurred bool
+	}{
+		{name: "same rule", rule: "FREQ=DAILY", wantRecurred: true},
+		{name: "changed rule", rule: "FREQ=WEEKLY", wantRecurred: false},
+		{name: "cleared rule", rule: "", wantRecurred: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := *task
+			task.SetRecurrence(tt.rule, "UTC")
+
+			if recurred := task.RecurredAt != nil; recurred != tt.wantRecurred {
+				t.Errorf("recurred = %v, want %v", recurred, tt.wantRecurred)
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,128 @@
+package shared
+
+import (
+	"context"
+	"log/slog"
+	"path"
+	"strconv"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3TaskAttachmentsRepository stores attachments in a bucket of an
+// S3-compatible object store, such as MinIO. The attachments of a task are
+// stored under the key prefix <prefix>/<task_id>/. Files larger than the part
+// size are uploaded in parts.
+type S3TaskAttachmentsRepository struct {
+	client *minio.Client
+	config *Config
+	logger *slog.Logger
+}
+
+var _ TaskAttachmentsRepository = (*S3TaskAttachmentsRepository)(nil)
+
+// NewS3TaskAttachmentsRepository connects to the object store and creates the
+// bucket if it does not exist.
+func NewS3TaskAttachmentsRepository(ctx context.Context, config *Config, logger *slog.Logger) (*S3TaskAttachmentsRepository, error) {
+	client, err := minio.New(config.Shared.S3Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(config.Shared.S3AccessKey, config.Shared.S3SecretKey, ""),
+		Secure: config.Shared.S3UseSSL,
+		Region: config.Shared.S3Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	repo := &S3TaskAttachmentsRepository{client, config, logger}
+
+	if err := repo.ensureBucket(ctx); err != nil {
+		return nil, err
+	}
+
+	return repo, nil
+}
+
+func (repo *S3TaskAttachmentsRepository) GetAttachment(ctx context.Context, taskID int, attachment *Attachment) (*AttachmentContent, error) {
+	obj, err := repo.client.GetObject(ctx, repo.config.Shared.S3Bucket, repo.getObjectKey(taskID, attachment.StorageKey), minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := obj.Stat()
+	if err != nil {
+		obj.Close()
+
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return NewAttachmentContent(attachment, obj, info.Size, info.ContentType, info.LastModified)
+}
+
+func (repo *S3TaskAttachmentsRepository) SaveAttachments(ctx context.Context, taskID int, uploads []*AttachmentUpload) error {
+	for _, upload := range uploads {
+		srcFile, err := upload.File.Open()
+		if err != nil {
+			return err
+		}
+		defer srcFile.Close()
+
+		opts := minio.PutObjectOptions{
+			ContentType: upload.Attachment.ContentType,
+			PartSize:    repo.config.Shared.S3PartSize,
+		}
+
+		if _, err := repo.client.PutObject(ctx, repo.config.Shared.S3Bucket, repo.getObjectKey(taskID, upload.Attachment.StorageKey), srcFile, upload.File.Size, opts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (repo *S3TaskAttachmentsRepository) DeleteAttachments(ctx context.Context, taskID int, deleted []*Attachment) error {
+	for _, a := range deleted {
+		if err := repo.client.RemoveObject(ctx, repo.config.Shared.S3Bucket, repo.getObjectKey(taskID, a.StorageKey), minio.RemoveObjectOptions{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (repo *S3TaskAttachmentsRepository) DeleteTask(ctx context.Context, taskID int) error {
+	objects := repo.client.ListObjects(ctx, repo.config.Shared.S3Bucket, minio.ListObjectsOptions{
+		Prefix:    repo.getTaskPrefix(taskID),
+		Recursive: true,
+	})
+
+	for result := range repo.client.RemoveObjects(ctx, repo.config.Shared.S3Bucket, objects, minio.RemoveObjectsOptions{}) {
+		if result.Err != nil {
+			return result.Err
+		}
+	}
+
+	return nil
+}
+
+func (repo *S3TaskAttachmentsRepository) ensureBucket(ctx context.Context) error {
+	exists, err := repo.client.BucketExists(ctx, repo.config.Shared.S3Bucket)
+	if err != nil || exists {
+		return err
+	}
+
+	repo.logger.Info("create attachments bucket", slog.String("bucket", repo.config.Shared.S3Bucket))
+
+	return repo.client.MakeBucket(ctx, repo.config.Shared.S3Bucket, minio.MakeBucketOptions{Region: repo.config.Shared.S3Region})
+}
+
+func (repo *S3TaskAttachmentsRepository) getObjectKey(taskID int, storageKey string) string {
+	return repo.getTaskPrefix(taskID) + storageKey
+}
+
+func (repo *S3TaskAttachmentsRepository) getTaskPrefix(taskID int) string {
+	return path.Join(repo.config.Shared.S3Prefix, strconv.Itoa(taskID)) + "/"
+}
@@ -0,0 +1,62 @@
+package shared
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"hash/fnv"
+	"time"
+)
+
+var TagColors = []string{
+	"blue",
+	"indigo",
+	"purple",
+	"pink",
+	"red",
+	"orange",
+	"yellow",
+	"green",
+	"teal",
+	"cyan",
+}
+
+type Tags []*Tag
+
+type Tag struct {
+	ID        int       `json:"id"`
+	UserID    string    `json:"user_id"`
+	Name      string    `json:"name"`
+	Color     string    `json:"color"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TagColor picks a stable color for a tag name so that a tag keeps its color
+// when it is removed and later created again.
+func TagColor(name string) string {
+	h := fnv.New32a()
+	h.Write([]byte(name))
+
+	return TagColors[h.Sum32()%uint32(len(TagColors))]
+}
+
+func (t Tags) Names() []string {
+	names := make([]string, len(t))
+	for i, tag := range t {
+		names[i] = tag.Name
+	}
+	return names
+}
+
+func (t *Tags) Value() (driver.Value, error) {
+	return json.Marshal(t)
+}
+
+func (t *Tags) Scan(src any) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte")
+	}
+
+	return json.Unmarshal(b, t)
+}
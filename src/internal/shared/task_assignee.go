@@ -0,0 +1,56 @@
+package shared
+
+import "strings"
+
+// Assign delegates the task to a user, identified either by user ID or by
+// email address. Empty values remove the assignee.
+func (t *Task) Assign(userID string, email string) {
+	now := UTCNow()
+
+	t.AssigneeUserID = userID
+	t.AssigneeEmail = strings.ToLower(email)
+	t.UpdatedAt = &now
+}
+
+// Assignee returns the user ID or the email address of the assignee, or an
+// empty string if the task is not assigned.
+func (t *Task) Assignee() string {
+	if t.AssigneeUserID != "" {
+		return t.AssigneeUserID
+	}
+
+	return t.AssigneeEmail
+}
+
+func (t *Task) IsAssignedTo(user *UserContext) bool {
+	if t.AssigneeUserID != "" {
+		return t.AssigneeUserID == user.ID
+	}
+
+	return t.AssigneeEmail != "" && t.AssigneeEmail == user.VerifiedEmail()
+}
+
+// TaskRecipient is a recipient of the notifications about a task. Email is
+// set for an assignee invited by email address, who has no user ID of their
+// own and is notified with the user ID of the owner.
+type TaskRecipient struct {
+	UserID string
+	Email  string
+}
+
+// NotificationRecipients returns the owner and the assignee of the task, who
+// are each sent their own task.{userID}.{taskID}.{kind} message, so that a
+// failed notification is retried without notifying the other again.
+func (t *Task) NotificationRecipients() []TaskRecipient {
+	recipients := []TaskRecipient{{UserID: t.UserID}}
+
+	if t.AssigneeUserID != "" {
+		if t.AssigneeUserID != t.UserID {
+			recipients = append(recipients, TaskRecipient{UserID: t.AssigneeUserID})
+		}
+	} else if t.AssigneeEmail != "" {
+		recipients = append(recipients, TaskRecipient{UserID: t.UserID, Email: t.AssigneeEmail})
+	}
+
+	return recipients
+}
@@ -0,0 +1,45 @@
+package shared
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTaskNotificationRecipients(t *testing.T) {
+	tests := []struct {
+		name           string
+		assigneeUserID string
+		assigneeEmail  string
+		want           []TaskRecipient
+	}{
+		{
+			name: "unassigned",
+			want: []TaskRecipient{{UserID: "owner"}},
+		},
+		{
+			name:           "assigned to a user",
+			assigneeUserID: "assignee",
+			want:           []TaskRecipient{{UserID: "owner"}, {UserID: "assignee"}},
+		},
+		{
+			name:           "assigned to the owner",
+			assigneeUserID: "owner",
+			want:           []TaskRecipient{{UserID: "owner"}},
+		},
+		{
+			name:          "assigned by email address",
+			assigneeEmail: "assignee@example.com",
+			want:          []TaskRecipient{{UserID: "owner"}, {UserID: "owner", Email: "assignee@example.com"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &Task{UserID: "owner", AssigneeUserID: tt.assigneeUserID, AssigneeEmail: tt.assigneeEmail}
+
+			if got := task.NotificationRecipients(); !slices.Equal(got, tt.want) {
+				t.Errorf("NotificationRecipients() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
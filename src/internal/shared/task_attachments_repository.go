@@ -2,12 +2,119 @@ package shared
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io"
 	"mime/multipart"
+	"time"
 )
 
+var ErrChecksumMismatch = errors.New("attachment checksum mismatch")
+
 type TaskAttachmentsRepository interface {
-	GetAttachment(ctx context.Context, taskID int, name string) ([]byte, error)
-	SaveAttachments(ctx context.Context, taskID int, fileHeaders []*multipart.FileHeader) error
-	DeleteAttachments(ctx context.Context, taskID int, deleted map[int]string) error
+	GetAttachment(ctx context.Context, taskID int, attachment *Attachment) (*AttachmentContent, error)
+	SaveAttachments(ctx context.Context, taskID int, uploads []*AttachmentUpload) error
+	DeleteAttachments(ctx context.Context, taskID int, deleted []*Attachment) error
 	DeleteTask(ctx context.Context, taskID int) error
 }
+
+// AttachmentUpload is an uploaded file and the attachment it is saved as.
+type AttachmentUpload struct {
+	Attachment *Attachment
+	File       *multipart.FileHeader
+}
+
+// AttachmentContent streams a stored attachment. The content can be seeked so
+// that only the requested ranges of large files are read. An empty content
+// type means that the type is not known. The caller must close the content.
+//
+// Content that is read from the start to the end is verified against the
+// checksum of the attachment. If the content has changed, the read that
+// completes it returns no data and fails with ErrChecksumMismatch, so that
+// the response is cut short. Ranges cannot be verified.
+type AttachmentContent struct {
+	io.ReadSeekCloser
+	Size        int64
+	ContentType string
+	ModTime     time.Time
+
+	checksum string
+	hash     hash.Hash
+	hashed   int64
+	err      error
+}
+
+// NewAttachmentContent returns the content of an attachment read from a
+// backend, which reports the size, the content type and the modification
+// time of the stored object. The recorded content type takes precedence.
+func NewAttachmentContent(attachment *Attachment, r io.ReadSeekCloser, size int64, contentType string, modTime time.Time) (*AttachmentContent, error) {
+	if attachment.SHA256 != "" && size != attachment.Size {
+		r.Close()
+		return nil, ErrChecksumMismatch
+	}
+
+	if attachment.ContentType != "" {
+		contentType = attachment.ContentType
+	}
+
+	c := &AttachmentContent{
+		ReadSeekCloser: r,
+		Size:           size,
+		ContentType:    contentType,
+		ModTime:        modTime,
+		checksum:       attachment.SHA256,
+	}
+
+	if c.checksum != "" {
+		c.hash = sha256.New()
+	}
+
+	return c, nil
+}
+
+func (c *AttachmentContent) Read(p []byte) (int, error) {
+	n, err := c.ReadSeekCloser.Read(p)
+
+	if c.hash != nil {
+		c.hash.Write(p[:n])
+		c.hashed += int64(n)
+
+		if c.hashed == c.Size {
+			if hex.EncodeToString(c.hash.Sum(nil)) != c.checksum {
+				c.err = ErrChecksumMismatch
+			}
+			c.hash = nil
+		}
+	}
+
+	if c.err != nil {
+		return 0, c.err
+	}
+
+	return n, err
+}
+
+func (c *AttachmentContent) Seek(offset int64, whence int) (int64, error) {
+	pos, err := c.ReadSeekCloser.Seek(offset, whence)
+	if err != nil {
+		return pos, err
+	}
+
+	if pos == 0 && c.checksum != "" && c.err == nil {
+		c.hash = sha256.New()
+		c.hashed = 0
+	} else if pos != c.hashed {
+		c.hash = nil
+	}
+
+	return pos, nil
+}
+
+// Err returns ErrChecksumMismatch if the content did not match the checksum
+// of the attachment. Responses are already being written when the mismatch is
+// detected, so callers can only report it.
+func (c *AttachmentContent) Err() error {
+	return c.err
+}
@@ -0,0 +1,159 @@
+package shared
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrBlocked is returned when a task is completed while it has open blockers.
+var ErrBlocked = errors.New("blocked")
+
+// ErrCycle is returned when a blocker would make a task depend on itself.
+var ErrCycle = errors.New("cycle")
+
+type TaskBlockers []*TaskBlocker
+
+// TaskBlocker is a task that has to be completed before the task it blocks.
+// Blockers in the trash do not block.
+type TaskBlocker struct {
+	ID          int        `json:"id"`
+	Name        string     `json:"name"`
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+type TaskUnblockedMsg struct {
+	Task  *Task  `json:"task"`
+	Email string `json:"email,omitempty"`
+}
+
+func (b *TaskBlocker) IsCompleted() bool {
+	return b.CompletedAt != nil
+}
+
+// Open returns the blockers that have not been completed.
+func (blockers TaskBlockers) Open() TaskBlockers {
+	var open TaskBlockers
+
+	for _, b := range blockers {
+		if !b.IsCompleted() {
+			open = append(open, b)
+		}
+	}
+
+	return open
+}
+
+func (blockers TaskBlockers) Contains(id int) bool {
+	for _, b := range blockers {
+		if b.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsBlocked reports whether the task has blockers that are still open.
+func (t *Task) IsBlocked() bool {
+	return 0 < len(t.Blockers.Open())
+}
+
+// SetBlockerCompleted updates the state of the blocker in the blockers of the
+// task after the blocker has been completed.
+func (t *Task) SetBlockerCompleted(blocker *Task) {
+	for _, b := range t.Blockers {
+		if b.ID == blocker.ID {
+			b.CompletedAt = blocker.CompletedAt
+		}
+	}
+}
+
+// OrderByBlockers returns the tasks ordered so that each task comes after the
+// tasks among them that block it. Blockers cannot form cycles.
+func OrderByBlockers(tasks []*Task) []*Task {
+	byID := make(map[int]*Task, len(tasks))
+	for _, t := range tasks {
+		byID[t.ID] = t
+	}
+
+	ordered := make([]*Task, 0, len(tasks))
+	visited := make(map[int]bool, len(tasks))
+
+	var visit func(t *Task)
+	visit = func(t *Task) {
+		if visited[t.ID] {
+			return
+		}
+		visited[t.ID] = true
+
+		for _, b := range t.Blockers {
+			if blocker, ok := byID[b.ID]; ok {
+				visit(blocker)
+			}
+		}
+
+		ordered = append(ordered, t)
+	}
+
+	for _, t := range tasks {
+		visit(t)
+	}
+
+	return ordered
+}
+
+// UnblockDependents records an unblocked event, and publishes it to the owner
+// and the assignee of the task, for each active task that the completed or
+// deleted blocker was the last open blocker of. The caller must persist the
+// blocker first.
+func UnblockDependents(ctx context.Context, txc TxContext, blocker *Task) error {
+	ids, err := txc.TaskBlockerRepository.GetDependentIDs(ctx, blocker.ID)
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+
+	tasks, err := txc.TaskRepository.GetByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, task := range tasks {
+		if task.CompletedAt != nil || task.IsBlocked() {
+			continue
+		}
+
+		if err := txc.TaskEventRepository.Create(ctx, NewTaskEvent(task.ID, TaskEventUnblocked, TaskEventData{Blocker: blocker.Name})); err != nil {
+			return err
+		}
+
+		for _, to := range task.NotificationRecipients() {
+			msg, err := NewOutboxMessage(fmt.Sprintf("task.%s.%d.unblocked", to.UserID, task.ID), TaskUnblockedMsg{Task: task, Email: to.Email})
+			if err != nil {
+				return err
+			}
+
+			if err := txc.OutboxRepository.Create(ctx, msg); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (blockers *TaskBlockers) Value() (driver.Value, error) {
+	return json.Marshal(blockers)
+}
+
+func (blockers *TaskBlockers) Scan(src any) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte")
+	}
+
+	return json.Unmarshal(b, blockers)
+}
@@ -0,0 +1,9 @@
+package shared
+
+import "context"
+
+type TaskBlockerRepository interface {
+	Create(ctx context.Context, taskID int, blockerID int) (bool, error)
+	Delete(ctx context.Context, taskID int, blockerID int) error
+	GetDependentIDs(ctx context.Context, blockerID int) ([]int, error)
+}
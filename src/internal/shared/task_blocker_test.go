@@ -0,0 +1,182 @@
+package shared
+
+import (
+	"context"
+	"slices"
+	"testing"
+)
+
+func taskIDs(tasks []*Task) []int {
+	ids := make([]int, len(tasks))
+	for i, t := range tasks {
+		ids[i] = t.ID
+	}
+
+	return ids
+}
+
+func blockedBy(id int, blockerIDs ...int) *Task {
+	task := &Task{ID: id, UserID: "owner"}
+	for _, b := range blockerIDs {
+		task.Blockers = append(task.Blockers, &TaskBlocker{ID: b})
+	}
+
+	return task
+}
+
+func TestOrderByBlockers(t *testing.T) {
+	tests := []struct {
+		name  string
+		tasks []*Task
+		want  []int
+	}{
+		{
+			name:  "no blockers keep their order",
+			tasks: []*Task{blockedBy(3), blockedBy(1), blockedBy(2)},
+			want:  []int{3, 1, 2},
+		},
+		{
+			name:  "chain",
+			tasks: []*Task{blockedBy(1, 2), blockedBy(2, 3), blockedBy(3)},
+			want:  []int{3, 2, 1},
+		},
+		{
+			name:  "shared blocker comes once",
+			tasks: []*Task{blockedBy(1, 3), blockedBy(2, 3), blockedBy(3)},
+			want:  []int{3, 1, 2},
+		},
+		{
+			name:  "blockers outside the tasks are ignored",
+			tasks: []*Task{blockedBy(1, 9), blockedBy(2)},
+			want:  []int{1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := taskIDs(OrderByBlockers(tt.tasks)); !slices.Equal(got, tt.want) {
+				t.Errorf("OrderByBlockers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTaskSetBlockerCompleted(t *testing.T) {
+	task := blockedBy(1, 2, 3)
+	now := UTCNow()
+
+	task.SetBlockerCompleted(&Task{ID: 2, CompletedAt: &now})
+
+	if !task.IsBlocked() {
+		t.Error("IsBlocked() = false with an open blocker")
+	}
+
+	task.SetBlockerCompleted(&Task{ID: 3, CompletedAt: &now})
+
+	if task.IsBlocked() {
+		t.Error("IsBlocked() = true with completed blockers")
+	}
+}
+
+func TestPostgresTaskBlockerRepositoryCreateSelf(t *testing.T) {
+	// A task blocking itself is refused before the database is queried.
+	repo := NewPostgresTaskBlockerRepository(nil)
+
+	if _, err := repo.Create(t.Context(), 1, 1); err != ErrCycle {
+		t.Errorf("Create() = %v, want ErrCycle", err)
+	}
+}
+
+type fakeBlockerTaskRepository struct {
+	TaskRepository
+	tasks []*Task
+}
+
+func (r *fakeBlockerTaskRepository) GetByIDs(_ context.Context, ids []int) ([]*Task, error) {
+	var tasks []*Task
+
+	for _, t := range r.tasks {
+		if slices.Contains(ids, t.ID) {
+			tasks = append(tasks, t)
+		}
+	}
+
+	return tasks, nil
+}
+
+type fakeTaskBlockerRepository struct {
+	TaskBlockerRepository
+	dependents []int
+}
+
+func (r *fakeTaskBlockerRepository) GetDependentIDs(_ context.Context, _ int) ([]int, error) {
+	return r.dependents, nil
+}
+
+type fakeTaskEventRepository struct {
+	TaskEventRepository
+	events []*TaskEvent
+}
+
+func (r *fakeTaskEventRepository) Create(_ context.Context, events ...*TaskEvent) error {
+	r.events = append(r.events, events...)
+	return nil
+}
+
+type fakeOutboxRepository struct {
+	OutboxRepository
+	msgs []*OutboxMessage
+}
+
+func (r *fakeOutboxRepository) Create(_ context.Context, msg *OutboxMessage) error {
+	r.msgs = append(r.msgs, msg)
+	return nil
+}
+
+func TestUnblockDependents(t *testing.T) {
+	now := UTCNow()
+	blocker := &Task{ID: 1, Name: "blocker", CompletedAt: &now}
+
+	unblocked := blockedBy(2, 1)
+	stillBlocked := blockedBy(3, 1, 4)
+	completed := blockedBy(5, 1)
+	completed.CompletedAt = &now
+	assigned := blockedBy(6, 1)
+	assigned.AssigneeUserID = "assignee"
+
+	for _, task := range []*Task{unblocked, stillBlocked, completed, assigned} {
+		task.SetBlockerCompleted(blocker)
+	}
+
+	events := &fakeTaskEventRepository{}
+	outbox := &fakeOutboxRepository{}
+	txc := TxContext{
+		TaskRepository:        &fakeBlockerTaskRepository{tasks: []*Task{unblocked, stillBlocked, completed, assigned}},
+		TaskBlockerRepository: &fakeTaskBlockerRepository{dependents: []int{2, 3, 5, 6}},
+		TaskEventRepository:   events,
+		OutboxRepository:      outbox,
+	}
+
+	if err := UnblockDependents(t.Context(), txc, blocker); err != nil {
+		t.Fatal(err)
+	}
+
+	var eventTaskIDs []int
+	for _, e := range events.events {
+		eventTaskIDs = append(eventTaskIDs, e.TaskID)
+	}
+
+	if want := []int{2, 6}; !slices.Equal(eventTaskIDs, want) {
+		t.Errorf("unblocked events for tasks %v, want %v", eventTaskIDs, want)
+	}
+
+	var subjects []string
+	for _, msg := range outbox.msgs {
+		subjects = append(subjects, msg.Subject)
+	}
+
+	want := []string{"task.owner.2.unblocked", "task.owner.6.unblocked", "task.assignee.6.unblocked"}
+	if !slices.Equal(subjects, want) {
+		t.Errorf("published subjects %v, want %v", subjects, want)
+	}
+}
@@ -0,0 +1,75 @@
+package shared
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// TaskComment is a message in the discussion of a task. Comments are written
+// in Markdown and only their author can edit or delete them.
+type TaskComment struct {
+	ID        int        `json:"id"`
+	TaskID    int        `json:"task_id"`
+	UserID    string     `json:"user_id"`
+	UserName  string     `json:"user_name"`
+	Body      string     `json:"body"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+func NewTaskComment(taskID int, body string) *TaskComment {
+	return &TaskComment{
+		TaskID:    taskID,
+		Body:      body,
+		CreatedAt: UTCNow(),
+	}
+}
+
+func (c *TaskComment) Update(body string) {
+	now := UTCNow()
+
+	c.Body = body
+	c.UpdatedAt = &now
+}
+
+func (c *TaskComment) IsEdited() bool {
+	return c.UpdatedAt != nil
+}
+
+func (c *TaskComment) IsAuthoredBy(user *UserContext) bool {
+	return user != nil && c.UserID == user.ID
+}
+
+// AuthorizeTaskComment returns ErrForbidden unless the user in the context
+// wrote the comment.
+func AuthorizeTaskComment(ctx context.Context, comment *TaskComment) error {
+	user, _ := GetUserContext(ctx)
+
+	if !comment.IsAuthoredBy(user) {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+// Recipients returns the IDs of the users who are notified about the comments
+// of the task: the owner, the members and the assignee known by user ID,
+// except the author of the comment.
+func (c *TaskComment) Recipients(task *Task) []string {
+	ids := []string{task.UserID, task.AssigneeUserID}
+
+	for _, m := range task.Members {
+		ids = append(ids, m.UserID)
+	}
+
+	var recipients []string
+
+	for _, id := range ids {
+		if id != "" && id != c.UserID && !slices.Contains(recipients, id) {
+			recipients = append(recipients, id)
+		}
+	}
+
+	return recipients
+}
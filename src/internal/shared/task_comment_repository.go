@@ -0,0 +1,11 @@
+package shared
+
+import "context"
+
+type TaskCommentRepository interface {
+	Create(ctx context.Context, comment *TaskComment) error
+	Update(ctx context.Context, comment *TaskComment) error
+	Delete(ctx context.Context, taskID int, id int) error
+	GetByID(ctx context.Context, taskID int, id int) (*TaskComment, error)
+	GetByTaskID(ctx context.Context, taskID int) ([]*TaskComment, error)
+}
@@ -0,0 +1,146 @@
+package shared
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	TaskEventCreated           = "created"
+	TaskEventRenamed           = "renamed"
+	TaskEventRescheduled       = "rescheduled"
+	TaskEventAttachmentAdded   = "attachment_added"
+	TaskEventAttachmentRemoved = "attachment_removed"
+	TaskEventCompleted         = "completed"
+	TaskEventReopened          = "reopened"
+	TaskEventNotified          = "notified"
+	TaskEventDeleted           = "deleted"
+	TaskEventRestored          = "restored"
+	TaskEventShared            = "shared"
+	TaskEventUnshared          = "unshared"
+	TaskEventMoved             = "moved"
+	TaskEventAssigned          = "assigned"
+	TaskEventUnassigned        = "unassigned"
+	TaskEventBlocked           = "blocked"
+	TaskEventBlockerRemoved    = "blocker_removed"
+	TaskEventUnblocked         = "unblocked"
+)
+
+const (
+	TaskNotificationExpiring = "expiring"
+	TaskNotificationExpired  = "expired"
+)
+
+// TaskEvent is an entry in the change history of a task. Events are never
+// changed, but they are deleted with the task when the task is permanently
+// deleted. Events recorded by background jobs have no user.
+type TaskEvent struct {
+	ID        int           `json:"id"`
+	TaskID    int           `json:"task_id"`
+	UserID    string        `json:"user_id"`
+	UserName  string        `json:"user_name"`
+	Type      string        `json:"type"`
+	Data      TaskEventData `json:"data"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+type TaskEventData struct {
+	OldName      string     `json:"old_name,omitempty"`
+	NewName      string     `json:"new_name,omitempty"`
+	OldExpiresAt *time.Time `json:"old_expires_at,omitempty"`
+	NewExpiresAt *time.Time `json:"new_expires_at,omitempty"`
+	FileName     string     `json:"file_name,omitempty"`
+	Notification string     `json:"notification,omitempty"`
+	Member       string     `json:"member,omitempty"`
+	Role         TaskRole   `json:"role,omitempty"`
+	Project      string     `json:"project,omitempty"`
+	Blocker      string     `json:"blocker,omitempty"`
+}
+
+func NewTaskEvent(taskID int, eventType string, data TaskEventData) *TaskEvent {
+	return &TaskEvent{
+		TaskID:    taskID,
+		Type:      eventType,
+		Data:      data,
+		CreatedAt: UTCNow(),
+	}
+}
+
+// NewTaskUpdateEvents returns the events for the changes made to a task,
+// given a copy of the task taken before the changes.
+func NewTaskUpdateEvents(old Task, task *Task) []*TaskEvent {
+	var events []*TaskEvent
+
+	if old.Name != task.Name {
+		events = append(events, NewTaskEvent(task.ID, TaskEventRenamed, TaskEventData{
+			OldName: old.Name,
+			NewName: task.Name,
+		}))
+	}
+
+	if !equalTimes(old.ExpiresAt, task.ExpiresAt) {
+		events = append(events, NewTaskEvent(task.ID, TaskEventRescheduled, TaskEventData{
+			OldExpiresAt: old.ExpiresAt,
+			NewExpiresAt: task.ExpiresAt,
+		}))
+	}
+
+	if old.Assignee() != task.Assignee() {
+		if task.Assignee() == "" {
+			events = append(events, NewTaskEvent(task.ID, TaskEventUnassigned, TaskEventData{Member: old.Assignee()}))
+		} else {
+			events = append(events, NewTaskEvent(task.ID, TaskEventAssigned, TaskEventData{Member: task.Assignee()}))
+		}
+	}
+
+	return events
+}
+
+// NewTaskAttachmentEvents returns the events for attachments added to and
+// removed from a task.
+func NewTaskAttachmentEvents(taskID int, inserted []*Attachment, deleted []*Attachment) []*TaskEvent {
+	var events []*TaskEvent
+
+	for _, a := range inserted {
+		events = append(events, NewTaskEvent(taskID, TaskEventAttachmentAdded, TaskEventData{FileName: a.FileName}))
+	}
+
+	deleted = slices.SortedFunc(slices.Values(deleted), func(a, b *Attachment) int {
+		return strings.Compare(a.FileName, b.FileName)
+	})
+
+	for _, a := range deleted {
+		events = append(events, NewTaskEvent(taskID, TaskEventAttachmentRemoved, TaskEventData{FileName: a.FileName}))
+	}
+
+	return events
+}
+
+func (e *TaskEvent) IsSystem() bool {
+	return e.UserID == ""
+}
+
+func (d TaskEventData) Value() (driver.Value, error) {
+	return json.Marshal(d)
+}
+
+func (d *TaskEventData) Scan(src any) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte")
+	}
+
+	return json.Unmarshal(b, d)
+}
+
+func equalTimes(a *time.Time, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(*b)
+}
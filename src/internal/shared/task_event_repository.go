@@ -0,0 +1,8 @@
+package shared
+
+import "context"
+
+type TaskEventRepository interface {
+	Create(ctx context.Context, events ...*TaskEvent) error
+	GetByTaskID(ctx context.Context, taskID int) ([]*TaskEvent, error)
+}
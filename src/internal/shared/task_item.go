@@ -0,0 +1,74 @@
+package shared
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+type TaskItems []*TaskItem
+
+type TaskItem struct {
+	ID          int        `json:"id"`
+	TaskID      int        `json:"task_id"`
+	Name        string     `json:"name"`
+	Position    int        `json:"position"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+func NewTaskItem(taskID int, name string) *TaskItem {
+	now := UTCNow()
+
+	return &TaskItem{
+		TaskID:    taskID,
+		Name:      name,
+		CreatedAt: now,
+	}
+}
+
+func (i *TaskItem) Update(name string, completed bool) {
+	now := UTCNow()
+
+	i.Name = name
+	i.UpdatedAt = &now
+
+	if !completed {
+		i.CompletedAt = nil
+	} else if i.CompletedAt == nil {
+		i.CompletedAt = &now
+	}
+}
+
+func (i *TaskItem) IsCompleted() bool {
+	return i.CompletedAt != nil
+}
+
+func (items TaskItems) CompletedCount() int {
+	count := 0
+	for _, i := range items {
+		if i.IsCompleted() {
+			count++
+		}
+	}
+	return count
+}
+
+func (items TaskItems) IsCompleted() bool {
+	return 0 < len(items) && items.CompletedCount() == len(items)
+}
+
+func (items *TaskItems) Value() (driver.Value, error) {
+	return json.Marshal(items)
+}
+
+func (items *TaskItems) Scan(src any) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte")
+	}
+
+	return json.Unmarshal(b, items)
+}
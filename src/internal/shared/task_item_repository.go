@@ -0,0 +1,11 @@
+package shared
+
+import "context"
+
+type TaskItemRepository interface {
+	Create(ctx context.Context, item *TaskItem) error
+	Update(ctx context.Context, item *TaskItem) error
+	Delete(ctx context.Context, taskID int, id int) error
+	GetByID(ctx context.Context, taskID int, id int) (*TaskItem, error)
+	GetByTaskID(ctx context.Context, taskID int) ([]*TaskItem, error)
+}
@@ -0,0 +1,109 @@
+package shared
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+)
+
+type TaskRole string
+
+const (
+	TaskRoleOwner  TaskRole = "owner"
+	TaskRoleEditor TaskRole = "editor"
+	TaskRoleViewer TaskRole = "viewer"
+)
+
+var SupportedTaskMemberRoles = []TaskRole{
+	TaskRoleViewer,
+	TaskRoleEditor,
+}
+
+type TaskMembers []*TaskMember
+
+// TaskMember is a user a task is shared with. The user is identified either
+// by user ID or by email address, whichever the owner used in the invite.
+type TaskMember struct {
+	ID        int       `json:"id"`
+	TaskID    int       `json:"task_id"`
+	UserID    string    `json:"user_id"`
+	Email     string    `json:"email"`
+	Role      TaskRole  `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewTaskMember(taskID int, userID string, email string, role TaskRole) *TaskMember {
+	return &TaskMember{
+		TaskID:    taskID,
+		UserID:    userID,
+		Email:     strings.ToLower(email),
+		Role:      role,
+		CreatedAt: UTCNow(),
+	}
+}
+
+func (m *TaskMember) Matches(user *UserContext) bool {
+	if m.UserID != "" {
+		return m.UserID == user.ID
+	}
+
+	return m.Email != "" && m.Email == user.VerifiedEmail()
+}
+
+func (m *TaskMember) Identity() string {
+	if m.UserID != "" {
+		return m.UserID
+	}
+
+	return m.Email
+}
+
+// RoleOf returns the role of the user in the task, or an empty role if the
+// task is not shared with the user. Without a user, as in background jobs,
+// the role is that of the owner. The assignee of the task is an editor.
+func (t *Task) RoleOf(user *UserContext) TaskRole {
+	if user == nil || t.UserID == user.ID {
+		return TaskRoleOwner
+	}
+
+	if t.IsAssignedTo(user) {
+		return TaskRoleEditor
+	}
+
+	for _, m := range t.Members {
+		if m.Matches(user) {
+			return m.Role
+		}
+	}
+
+	return ""
+}
+
+// AuthorizeTask returns ErrForbidden unless the user in ctx has one of the
+// given roles in the task.
+func AuthorizeTask(ctx context.Context, task *Task, roles ...TaskRole) error {
+	user, _ := GetUserContext(ctx)
+
+	if !slices.Contains(roles, task.RoleOf(user)) {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+func (members *TaskMembers) Value() (driver.Value, error) {
+	return json.Marshal(members)
+}
+
+func (members *TaskMembers) Scan(src any) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte")
+	}
+
+	return json.Unmarshal(b, members)
+}
@@ -0,0 +1,8 @@
+package shared
+
+import "context"
+
+type TaskMemberRepository interface {
+	Create(ctx context.Context, member *TaskMember) error
+	Delete(ctx context.Context, taskID int, id int) error
+}
@@ -0,0 +1,72 @@
+package shared
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// TaskCursor holds the sort keys of the last task of a page. The next page
+// starts right after it, so pages stay stable while tasks are added or
+// removed in between requests.
+type TaskCursor struct {
+	ID          int        `json:"id"`
+	Priority    Priority   `json:"priority"`
+	Name        string     `json:"name"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+}
+
+// TaskFilter narrows a task list down to a tag, to a project and to the tasks
+// assigned to the current user. Zero values match all tasks.
+type TaskFilter struct {
+	Tag          string
+	ProjectID    int
+	AssignedToMe bool
+}
+
+type TaskPage struct {
+	Tasks []*Task
+	Next  *TaskCursor
+}
+
+type TaskCounts struct {
+	Active    int
+	Completed int
+	Deleted   int
+}
+
+func NewTaskCursor(task *Task) *TaskCursor {
+	return &TaskCursor{
+		ID:          task.ID,
+		Priority:    task.Priority,
+		Name:        task.Name,
+		ExpiresAt:   task.ExpiresAt,
+		CreatedAt:   task.CreatedAt,
+		CompletedAt: task.CompletedAt,
+		DeletedAt:   task.DeletedAt,
+	}
+}
+
+func ParseTaskCursor(value string) (*TaskCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errors.New("invalid task cursor")
+	}
+
+	cursor := &TaskCursor{}
+
+	if err := json.Unmarshal(b, cursor); err != nil || cursor.ID <= 0 {
+		return nil, errors.New("invalid task cursor")
+	}
+
+	return cursor, nil
+}
+
+func (c *TaskCursor) String() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,56 @@
+package shared
+
+import (
+	"encoding/base64"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTaskCursorString(t *testing.T) {
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		cursor *TaskCursor
+	}{
+		{"created", &TaskCursor{ID: 1, CreatedAt: at}},
+		{"expiring", &TaskCursor{ID: 2, Priority: PriorityUrgent, Name: "Pay rent", ExpiresAt: &at, CreatedAt: at}},
+		{"completed", &TaskCursor{ID: 3, Name: "Ääkköset & ?/=", CreatedAt: at, CompletedAt: &at}},
+		{"deleted", &TaskCursor{ID: 4, CreatedAt: at, DeletedAt: &at}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTaskCursor(tt.cursor.String())
+			if err != nil {
+				t.Fatalf("ParseTaskCursor() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.cursor) {
+				t.Errorf("ParseTaskCursor() = %+v, want %+v", got, tt.cursor)
+			}
+		})
+	}
+}
+
+func TestParseTaskCursorInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"empty", ""},
+		{"not base64", "not a cursor!"},
+		{"not json", base64.RawURLEncoding.EncodeToString([]byte("cursor"))},
+		{"padded", base64.URLEncoding.EncodeToString([]byte(`{"id":1}`))},
+		{"missing id", base64.RawURLEncoding.EncodeToString([]byte(`{"name":"a"}`))},
+		{"negative id", base64.RawURLEncoding.EncodeToString([]byte(`{"id":-1}`))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if cursor, err := ParseTaskCursor(tt.value); err == nil {
+				t.Errorf("ParseTaskCursor(%q) = %+v, want error", tt.value, cursor)
+			}
+		})
+	}
+}
@@ -8,12 +8,20 @@ import (
 type TaskRepository interface {
 	Create(ctx context.Context, task *Task) error
 	Update(ctx context.Context, task *Task) error
-	UpdateAttachments(ctx context.Context, taskID int, inserted []string, deleted map[int]string) error
-	Delete(ctx context.Context, id int) error
+	UpdateAttachments(ctx context.Context, taskID int, inserted []*Attachment, updated []*Attachment, deleted []*Attachment) error
+	UpdateTags(ctx context.Context, taskID int, names []string) error
 	GetByID(ctx context.Context, id int) (*Task, error)
-	GetActive(ctx context.Context, offset int, limit int) ([]*Task, error)
-	GetCompleted(ctx context.Context, offset int, limit int) ([]*Task, error)
+	GetByIDs(ctx context.Context, ids []int) ([]*Task, error)
+	GetActive(ctx context.Context, filter TaskFilter, sort TaskSort, cursor *TaskCursor, limit int) (*TaskPage, error)
+	GetCompleted(ctx context.Context, filter TaskFilter, sort TaskSort, cursor *TaskCursor, limit int) (*TaskPage, error)
+	GetDeleted(ctx context.Context, cursor *TaskCursor, limit int) (*TaskPage, error)
+	GetCounts(ctx context.Context, filter TaskFilter) (*TaskCounts, error)
+	GetAttachmentUsage(ctx context.Context, userID string) (int64, error)
+	LockAttachmentUsage(ctx context.Context, userID string) error
+	GetTags(ctx context.Context) ([]*Tag, error)
+	Search(ctx context.Context, query string, language string, offset int, limit int) ([]*TaskSearchResult, error)
 	GetExpiring(ctx context.Context, d time.Duration) ([]*Task, error)
 	GetExpired(ctx context.Context) ([]*Task, error)
-	DeleteCompleted(ctx context.Context, d time.Duration) (int64, error)
+	DeleteCompleted(ctx context.Context, d time.Duration) ([]int, error)
+	PurgeDeleted(ctx context.Context, d time.Duration) ([]int, error)
 }
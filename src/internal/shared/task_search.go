@@ -0,0 +1,29 @@
+package shared
+
+// Search highlights are delimited with characters from the Unicode private
+// use area so that they can never clash with user input and the highlighted
+// text can be escaped safely before the delimiters are turned into markup.
+const (
+	HighlightStart = "\uE000"
+	HighlightStop  = "\uE001"
+)
+
+var textSearchConfigs = map[string]string{
+	"en": "english",
+	"fi": "finnish",
+}
+
+type TaskSearchResult struct {
+	Task                 *Task
+	Rank                 float64
+	NameHighlight        string
+	DescriptionHighlight string
+}
+
+func TextSearchConfig(language string) string {
+	if config, ok := textSearchConfigs[language]; ok {
+		return config
+	}
+
+	return "simple"
+}
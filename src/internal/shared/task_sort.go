@@ -0,0 +1,26 @@
+package shared
+
+type TaskSort string
+
+const (
+	TaskSortCreated    TaskSort = "created"
+	TaskSortCompleted  TaskSort = "completed"
+	TaskSortPriority   TaskSort = "priority"
+	TaskSortExpiration TaskSort = "expiration"
+	TaskSortName       TaskSort = "name"
+)
+
+var SupportedActiveTaskSorts = []TaskSort{
+	TaskSortCreated,
+	TaskSortPriority,
+	TaskSortExpiration,
+	TaskSortName,
+}
+
+var SupportedCompletedTaskSorts = []TaskSort{
+	TaskSortCompleted,
+	TaskSortCreated,
+	TaskSortPriority,
+	TaskSortExpiration,
+	TaskSortName,
+}
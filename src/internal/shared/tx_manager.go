@@ -14,7 +14,15 @@ type DB interface {
 }
 
 type TxContext struct {
-	TaskRepository TaskRepository
+	TaskRepository        TaskRepository
+	TaskItemRepository    TaskItemRepository
+	TaskEventRepository   TaskEventRepository
+	TaskMemberRepository  TaskMemberRepository
+	TaskCommentRepository TaskCommentRepository
+	TaskBlockerRepository TaskBlockerRepository
+	ProjectRepository     ProjectRepository
+	APITokenRepository    APITokenRepository
+	OutboxRepository      OutboxRepository
 }
 
 type TxManager interface {
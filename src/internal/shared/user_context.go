@@ -3,6 +3,8 @@ package shared
 import (
 	"context"
 	"errors"
+	"slices"
+	"strings"
 )
 
 type userCtxKeyType string
@@ -12,11 +14,13 @@ const userCtxKey userCtxKeyType = "user"
 var ErrUserContextNotFound = errors.New("user context not found")
 
 type UserContext struct {
-	ID          string
-	Name        string
-	Email       string
-	IDToken     string
-	AccessToken string
+	ID            string
+	Name          string
+	Email         string
+	EmailVerified bool
+	IDToken       string
+	AccessToken   string
+	Scopes        []string
 }
 
 func WithUserContext(ctx context.Context, user *UserContext) context.Context {
@@ -30,3 +34,19 @@ func GetUserContext(ctx context.Context) (*UserContext, error) {
 	}
 	return user, nil
 }
+
+// HasScope reports whether the user is allowed to use the given scope.
+// A nil scope list means the user is not restricted.
+func (u *UserContext) HasScope(scope string) bool {
+	return u.Scopes == nil || slices.Contains(u.Scopes, scope)
+}
+
+// VerifiedEmail returns the lowercased email address of the user if the
+// identity provider has verified it, and an empty string otherwise. Tasks
+// shared by email address are only accessible with a verified address.
+func (u *UserContext) VerifiedEmail() string {
+	if !u.EmailVerified {
+		return ""
+	}
+	return strings.ToLower(u.Email)
+}